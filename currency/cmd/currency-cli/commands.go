@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+)
+
+// command is a command of the client, nargs checks the number of
+// arguments before connecting
+type command struct {
+	run   func(ctx context.Context, cc protos.CurrencyClient, o *options, args []string, p printer) error
+	nargs func(n int) bool
+}
+
+func exactly(n int) func(int) bool {
+	return func(got int) bool { return got == n }
+}
+
+var commands = map[string]command{
+	"rate":    {rate, exactly(2)},
+	"convert": {convert, exactly(3)},
+	"list":    {list, exactly(0)},
+	"watch":   {watch, func(n int) bool { return n > 0 && n%2 == 0 }},
+}
+
+func rate(ctx context.Context, cc protos.CurrencyClient, o *options, args []string, p printer) error {
+	rr, err := rateRequest(o, args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	resp, err := cc.GetRate(ctx, rr)
+	if err != nil {
+		return err
+	}
+
+	return p.Rate(resp)
+}
+
+func convert(ctx context.Context, cc protos.CurrencyClient, o *options, args []string, p printer) error {
+	amount, err := parseMoney(args[0])
+	if err != nil {
+		return err
+	}
+
+	side, err := parseSide(o.side)
+	if err != nil {
+		return err
+	}
+
+	mode, ok := protos.RoundingMode_value["ROUND_"+strings.ToUpper(strings.ReplaceAll(o.rounding, "-", "_"))]
+	if !ok {
+		return fmt.Errorf("unknown rounding %q, expected half-even, half-up or down", o.rounding)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	resp, err := cc.Convert(ctx, &protos.ConvertRequest{
+		Amount:          amount,
+		BaseCode:        args[1],
+		DestinationCode: args[2],
+		Rounding:        protos.RoundingMode(mode),
+		Side:            side,
+		Tier:            o.tier,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.Conversion(amount, resp)
+}
+
+func list(ctx context.Context, cc protos.CurrencyClient, o *options, args []string, p printer) error {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	resp, err := cc.ListCurrencies(ctx, &protos.ListCurrenciesRequest{})
+	if err != nil {
+		return err
+	}
+
+	return p.Currencies(resp)
+}
+
+// watch subscribes to every pair and prints the updates until the stream
+// ends or the client is interrupted, the timeout does not apply
+func watch(ctx context.Context, cc protos.CurrencyClient, o *options, args []string, p printer) error {
+	stream, err := cc.SubscribeRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(args); i += 2 {
+		rr, err := rateRequest(o, args[i], args[i+1])
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(&protos.StreamingRateRequest{Message: &protos.StreamingRateRequest_Subscribe{Subscribe: rr}})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = p.Update(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func rateRequest(o *options, base, dest string) (*protos.RateRequest, error) {
+	side, err := parseSide(o.side)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.RateRequest{BaseCode: base, DestinationCode: dest, Side: side, Tier: o.tier}, nil
+}
+
+func parseSide(s string) (protos.Side, error) {
+	v, ok := protos.Side_value["SIDE_"+strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown side %q, expected mid, bid or ask", s)
+	}
+
+	return protos.Side(v), nil
+}
@@ -0,0 +1,196 @@
+// Command currency-cli queries the Currency service from the terminal
+//
+//	currency-cli [flags] rate EUR USD
+//	currency-cli [flags] convert 10 EUR JPY
+//	currency-cli [flags] convert -10 EUR JPY
+//	currency-cli [flags] list
+//	currency-cli [flags] watch USD GBP [BASE DEST ...]
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// options holds the flags of the client
+type options struct {
+	addr    string
+	timeout time.Duration
+	output  string
+
+	tls        bool
+	caFile     string
+	serverName string
+	skipVerify bool
+
+	side     string
+	tier     string
+	rounding string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("currency-cli", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(fs) }
+
+	o := &options{}
+	fs.StringVar(&o.addr, "addr", "localhost:9092", "address of the Currency service")
+	fs.DurationVar(&o.timeout, "timeout", 5*time.Second, "timeout of the connection and of every call except watch")
+	fs.StringVar(&o.output, "o", "table", "output format: table or json")
+	fs.BoolVar(&o.tls, "tls", false, "connect with TLS")
+	fs.StringVar(&o.caFile, "ca-file", "", "PEM file of the certificate authorities trusted with -tls, the system pool by default")
+	fs.StringVar(&o.serverName, "server-name", "", "name the server certificate is verified against with -tls, the host of -addr by default")
+	fs.BoolVar(&o.skipVerify, "insecure-skip-verify", false, "do not verify the server certificate with -tls")
+	fs.StringVar(&o.side, "side", "mid", "side of the rate: mid, bid or ask")
+	fs.StringVar(&o.tier, "tier", "", "customer tier of the spread")
+	fs.StringVar(&o.rounding, "rounding", "half-even", "rounding of converted amounts: half-even, half-up or down")
+
+	pos, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		return 2
+	}
+
+	if len(pos) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	if o.output != "table" && o.output != "json" {
+		fmt.Fprintf(stderr, "unknown output format %q, expected table or json\n", o.output)
+		return 2
+	}
+
+	cmd, ok := commands[pos[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n", pos[0])
+		fs.Usage()
+		return 2
+	}
+
+	if !cmd.nargs(len(pos) - 1) {
+		fmt.Fprintf(stderr, "wrong number of arguments for %s\n", pos[0])
+		fs.Usage()
+		return 2
+	}
+
+	conn, err := dial(o)
+	if err != nil {
+		fmt.Fprintf(stderr, "unable to connect to %s: %s\n", o.addr, err)
+		return 1
+	}
+	defer conn.Close()
+
+	// an interrupt ends a watch and cancels pending calls
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err = cmd.run(ctx, protos.NewCurrencyClient(conn), o, pos[1:], newPrinter(o.output, stdout))
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			fmt.Fprintf(stderr, "%s: %s\n", st.Code(), st.Message())
+		} else {
+			fmt.Fprintln(stderr, err)
+		}
+		return 1
+	}
+
+	return 0
+}
+
+// parseArgs parses the flags wherever they appear and returns the other
+// arguments. Negative amounts are arguments and "--" ends the flags
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var pos []string
+	for len(args) > 0 {
+		a := args[0]
+		if a == "--" {
+			return append(pos, args[1:]...), nil
+		}
+
+		if !strings.HasPrefix(a, "-") || negativeAmount(a) {
+			pos = append(pos, a)
+			args = args[1:]
+			continue
+		}
+
+		// fs would take a negative amount or "--" after the flags for a flag
+		j := 1
+		for j < len(args) && args[j] != "--" && !negativeAmount(args[j]) {
+			j++
+		}
+
+		if err := fs.Parse(args[:j]); err != nil {
+			return nil, err
+		}
+		args = append(append([]string{}, fs.Args()...), args[j:]...)
+	}
+
+	return pos, nil
+}
+
+func usage(fs *flag.FlagSet) {
+	fmt.Fprint(fs.Output(), `Usage: currency-cli [flags] command [arguments]
+
+Commands:
+  rate BASE DEST                  print the rate of a pair
+  convert AMOUNT BASE DEST        convert an amount
+  list                            list the currencies with a rate
+  watch BASE DEST [BASE DEST...]  print the updates of the rates until interrupted
+
+Flags can follow the command, negative amounts are not taken for flags and
+-- ends the flags.
+
+Flags:
+`)
+	fs.PrintDefaults()
+}
+
+// dial connects to the service within the timeout
+func dial(o *options) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if o.tls {
+		cfg := &tls.Config{ServerName: o.serverName, InsecureSkipVerify: o.skipVerify}
+
+		if o.caFile != "" {
+			pem, err := os.ReadFile(o.caFile)
+			if err != nil {
+				return nil, err
+			}
+
+			cfg.RootCAs = x509.NewCertPool()
+			if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in %s", o.caFile)
+			}
+		}
+
+		creds = credentials.NewTLS(cfg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return grpc.DialContext(ctx, o.addr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+}
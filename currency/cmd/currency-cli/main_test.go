@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/server"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+)
+
+// startServer serves the currency service with fixed rates on a local port
+func startServer(t *testing.T) string {
+	log := hclog.NewNullLogger()
+
+	rates := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160}), "EUR", data.NewHistory(0), nil, nil)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gs := grpc.NewServer()
+	protos.RegisterCurrencyServer(gs, server.NewCurrency(rates, server.NewBroadcaster(8, server.DropOldest), time.Hour, 0, log))
+	go gs.Serve(l)
+	t.Cleanup(gs.Stop)
+
+	return l.Addr().String()
+}
+
+func TestCommands(t *testing.T) {
+	addr := startServer(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"rate", []string{"rate", "EUR", "USD"}, []string{"EUR/USD", "1.1", "fixed", "EUR>USD"}},
+		{"flags after the command", []string{"rate", "usd", "jpy", "-o", "json"}, []string{`"BaseCode"`, `"USD"`, `"Path"`}},
+		{"convert", []string{"convert", "10.5", "EUR", "JPY"}, []string{"10.5 EUR", "1680 JPY"}},
+		{"list", []string{"list"}, []string{"CODE", "JPY", "Yen"}},
+		{"negative amount", []string{"convert", "-10.5", "EUR", "JPY"}, []string{"-10.5 EUR", "-1680 JPY"}},
+		{"negative amount after flags", []string{"-o", "table", "convert", "-0.5", "EUR", "JPY", "-rounding", "down"}, []string{"-0.5 EUR", "-80 JPY"}},
+		{"end of the flags", []string{"convert", "--", "-1", "EUR", "JPY"}, []string{"-1 EUR", "-160 JPY"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(append([]string{"-addr", addr}, tc.args...), &stdout, &stderr)
+			if code != 0 {
+				t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+			}
+
+			for _, w := range tc.want {
+				if !strings.Contains(stdout.String(), w) {
+					t.Errorf("expected %q in the output:\n%s", w, stdout.String())
+				}
+			}
+		})
+	}
+}
+
+func TestCommandErrors(t *testing.T) {
+	addr := startServer(t)
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"unknown command", []string{"rates"}, 2, "unknown command"},
+		{"missing argument", []string{"rate", "EUR"}, 2, "wrong number of arguments"},
+		{"unknown output", []string{"-o", "yaml", "list"}, 2, "unknown output format"},
+		{"unknown currency", []string{"rate", "EUR", "XXX"}, 1, "InvalidArgument"},
+		{"invalid amount", []string{"convert", "ten", "EUR", "USD"}, 1, "invalid amount"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(append([]string{"-addr", addr}, tc.args...), &stdout, &stderr)
+			if code != tc.code {
+				t.Fatalf("expected exit code %d, got %d", tc.code, code)
+			}
+
+			if !strings.Contains(stderr.String(), tc.want) {
+				t.Errorf("expected %q in the errors:\n%s", tc.want, stderr.String())
+			}
+		})
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+		tier string
+	}{
+		{[]string{"-tier", "gold", "convert", "-5", "EUR", "USD"}, []string{"convert", "-5", "EUR", "USD"}, "gold"},
+		{[]string{"convert", "-tier", "gold", "-5", "EUR", "USD"}, []string{"convert", "-5", "EUR", "USD"}, "gold"},
+		{[]string{"convert", "--", "-tier", "EUR", "USD"}, []string{"convert", "-tier", "EUR", "USD"}, ""},
+		{[]string{"-tier", "gold", "--", "rate"}, []string{"rate"}, "gold"},
+	}
+
+	for _, tc := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		tier := fs.String("tier", "", "")
+
+		got, err := parseArgs(fs, tc.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.args, err)
+		}
+
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") || *tier != tc.tier {
+			t.Errorf("%v: expected %v with tier %q, got %v with tier %q", tc.args, tc.want, tc.tier, got, *tier)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+)
+
+// parseMoney parses a decimal amount such as "-12.5", at most 9 decimal
+// digits are allowed
+func parseMoney(s string) (*protos.Money, error) {
+	neg := strings.HasPrefix(s, "-")
+	units, frac, _ := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+
+	if units == "" && frac == "" || len(frac) > 9 {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+
+	m := &protos.Money{}
+	if units != "" {
+		u, err := strconv.ParseUint(units, 10, 63)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q", s)
+		}
+		m.Units = int64(u)
+	}
+
+	if frac != "" {
+		n, err := strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q", s)
+		}
+		m.Nanos = int32(n)
+	}
+
+	if neg {
+		m.Units, m.Nanos = -m.Units, -m.Nanos
+	}
+
+	return m, nil
+}
+
+// negativeAmount reports whether an argument is a negative amount rather than a flag
+func negativeAmount(s string) bool {
+	if !strings.HasPrefix(s, "-") {
+		return false
+	}
+
+	_, err := parseMoney(s)
+	return err == nil
+}
+
+// formatMoney writes an amount without trailing zeros
+func formatMoney(m *protos.Money) string {
+	sign := ""
+	units, nanos := m.GetUnits(), int64(m.GetNanos())
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	s := fmt.Sprintf("%s%d.%09d", sign, units, nanos)
+
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
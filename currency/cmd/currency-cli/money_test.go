@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in        string
+		units     int64
+		nanos     int32
+		formatted string
+	}{
+		{"10", 10, 0, "10"},
+		{"12.5", 12, 500_000_000, "12.5"},
+		{"0.000000001", 0, 1, "0.000000001"},
+		{".25", 0, 250_000_000, "0.25"},
+		{"-0.5", 0, -500_000_000, "-0.5"},
+		{"-3.75", -3, -750_000_000, "-3.75"},
+	}
+
+	for _, tc := range tests {
+		got, err := parseMoney(tc.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.in, err)
+		}
+
+		if got.Units != tc.units || got.Nanos != tc.nanos {
+			t.Errorf("%s: expected %d and %d nanos, got %v", tc.in, tc.units, tc.nanos, got)
+		}
+
+		if s := formatMoney(got); s != tc.formatted {
+			t.Errorf("%s: expected %s, got %s", tc.in, tc.formatted, s)
+		}
+	}
+
+	for _, in := range []string{"", "-", "1.2.3", "abc", "+1", "1.0000000001", "1e3"} {
+		if _, err := parseMoney(in); err == nil {
+			t.Errorf("expected an error for %q", in)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// printer writes the responses of the service in an output format
+type printer interface {
+	Rate(*protos.RateResponse) error
+	Conversion(*protos.Money, *protos.ConvertResponse) error
+	Currencies(*protos.ListCurrenciesResponse) error
+	// Update writes a single message of a rate subscription
+	Update(*protos.StreamingRateResponse) error
+}
+
+func newPrinter(format string, w io.Writer) printer {
+	if format == "json" {
+		return &jsonPrinter{w}
+	}
+
+	return &tablePrinter{w: w}
+}
+
+// jsonPrinter writes every response as JSON, updates are written one per line
+type jsonPrinter struct {
+	w io.Writer
+}
+
+func (p *jsonPrinter) write(m proto.Message, o protojson.MarshalOptions) error {
+	b, err := o.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(p.w, "%s\n", b)
+	return err
+}
+
+func (p *jsonPrinter) Rate(r *protos.RateResponse) error {
+	return p.write(r, protojson.MarshalOptions{Multiline: true})
+}
+
+func (p *jsonPrinter) Conversion(_ *protos.Money, r *protos.ConvertResponse) error {
+	return p.write(r, protojson.MarshalOptions{Multiline: true})
+}
+
+func (p *jsonPrinter) Currencies(r *protos.ListCurrenciesResponse) error {
+	return p.write(r, protojson.MarshalOptions{Multiline: true})
+}
+
+func (p *jsonPrinter) Update(r *protos.StreamingRateResponse) error {
+	return p.write(r, protojson.MarshalOptions{})
+}
+
+// tablePrinter writes the responses as aligned columns, updates of a
+// subscription are written as rows as they arrive
+type tablePrinter struct {
+	w      io.Writer
+	header bool
+}
+
+func (p *tablePrinter) table(header string, rows ...[]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, header)
+	for _, r := range rows {
+		fmt.Fprintln(tw, strings.Join(r, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func (p *tablePrinter) Rate(r *protos.RateResponse) error {
+	return p.table("PAIR\tSIDE\tRATE\tBID\tASK\tSOURCE\tAS OF\tPATH", []string{
+		r.BaseCode + "/" + r.DestinationCode,
+		strings.ToLower(strings.TrimPrefix(r.Side.String(), "SIDE_")),
+		formatFloat(r.Rate),
+		formatFloat(r.Bid),
+		formatFloat(r.Ask),
+		r.Source,
+		formatTime(r.AsOf),
+		strings.Join(r.Path, ">"),
+	})
+}
+
+func (p *tablePrinter) Conversion(amount *protos.Money, r *protos.ConvertResponse) error {
+	return p.table("FROM\tTO\tRATE\tPATH", []string{
+		formatMoney(amount) + " " + r.BaseCode,
+		formatMoney(r.GetAmount()) + " " + r.DestinationCode,
+		formatFloat(r.Rate),
+		strings.Join(r.Path, ">"),
+	})
+}
+
+func (p *tablePrinter) Currencies(r *protos.ListCurrenciesResponse) error {
+	rows := make([][]string, len(r.Currencies))
+	for i, c := range r.Currencies {
+		rows[i] = []string{c.Code, c.Name, strconv.Itoa(int(c.MinorUnits)), c.Symbol, formatTime(c.Updated)}
+	}
+
+	return p.table("CODE\tNAME\tDIGITS\tSYMBOL\tUPDATED", rows...)
+}
+
+func (p *tablePrinter) Update(r *protos.StreamingRateResponse) error {
+	switch msg := r.Message.(type) {
+	case *protos.StreamingRateResponse_RateResponse:
+		rr := msg.RateResponse
+
+		// rows are written as they arrive, the widths are fixed up front
+		if !p.header {
+			p.header = true
+			fmt.Fprintf(p.w, "%-20s  %-9s  %-18s  %-18s  %-18s  %s\n", "AS OF", "PAIR", "RATE", "BID", "ASK", "SOURCE")
+		}
+
+		_, err := fmt.Fprintf(p.w, "%-20s  %-9s  %-18s  %-18s  %-18s  %s\n",
+			formatTime(rr.AsOf), rr.BaseCode+"/"+rr.DestinationCode, formatFloat(rr.Rate), formatFloat(rr.Bid), formatFloat(rr.Ask), rr.Source)
+		return err
+	case *protos.StreamingRateResponse_Error:
+		st := status.FromProto(msg.Error)
+		_, err := fmt.Fprintf(p.w, "error: %s: %s\n", st.Code(), st.Message())
+		return err
+	}
+
+	// acks of the subscriptions are not shown in tables
+	return nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.AsTime().Format(time.RFC3339)
+}
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Config holds the runtime settings of the currency service
+type Config struct {
+	// Address the gRPC server listens on
+	Addr string
+	// HTTPAddr is the address of the HTTP/JSON gateway, empty disables it
+	HTTPAddr string
+	// AdminAddr is the address of the Admin service, empty disables it
+	AdminAddr string
+	// MetricsAddr is the address metrics are served on in the Prometheus
+	// text format, empty disables them
+	MetricsAddr string
+
+	// Provider selects the rate sources: ecb, file or fixed, a comma separated
+	// list is a chain where each provider is a fallback of the previous one
+	Provider string
+	// Providers is the chain of Provider split in order
+	Providers []string
+	// ProviderTimeout bounds a single request to a provider
+	ProviderTimeout time.Duration
+	// ProviderRetries and ProviderBackoff retry a failed provider, the backoff doubles with every retry
+	ProviderRetries int
+	ProviderBackoff time.Duration
+	// BreakerThreshold failures in a row skip a provider for BreakerCooldown
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// RefreshInterval is how often rates are reloaded from the providers
+	RefreshInterval time.Duration
+	// ECBURL overrides the location of the ECB daily feed
+	ECBURL string
+	// RatesFile is the path read by the file provider (.xml, .json or .csv)
+	RatesFile string
+	// FixedRates is the table served by the fixed provider, e.g. "USD=1.09,GBP=0.86"
+	// or with direct pair quotes "USD/JPY=147.2"
+	FixedRates string
+	// ProviderBase is the currency the codes of the provider are quoted against
+	ProviderBase string
+	// Pivot is the currency cross rates and the history are quoted through
+	Pivot string
+
+	// UpdateInterval is how often rates are updated and pushed to subscribers
+	UpdateInterval time.Duration
+	// QueueSize bounds the messages waiting to be sent to each subscriber
+	QueueSize int
+	// OverflowPolicy applies when the queue of a subscriber is full: drop-oldest, coalesce or disconnect
+	OverflowPolicy string
+	// Simulate turns the rate fluctuation simulator on
+	Simulate bool
+	// SimModel is the simulation model: random-walk, mean-reversion or gbm
+	SimModel string
+	// SimParam is the bound of the random walk, the reversion speed or the drift
+	SimParam float64
+	// SimVolatility is the relative volatility per update
+	SimVolatility float64
+	// SimCurrencyVolatility overrides the volatility per currency, e.g. "JPY=0.02,GBP=0.005"
+	SimCurrencyVolatility string
+	// SimSeed makes the simulation reproducible, 0 picks a random seed
+	SimSeed int64
+
+	// MaxRateAge is the age after which a rate is too stale to use, 0 allows any age
+	MaxRateAge time.Duration
+
+	// HealthStaleAfter is the age of the last successful refresh after which
+	// the health service reports NOT_SERVING, 0 never does
+	HealthStaleAfter time.Duration
+
+	// HistoryRetention is how long recorded rates are kept for historical lookups
+	HistoryRetention time.Duration
+	// AuditLog is the file every change of the quotes is appended to, empty keeps no record
+	AuditLog string
+	// AuditSync batches the syncs of the audit log to disk, 0 syncs every change
+	AuditSync time.Duration
+	// Replay rebuilds the rates from the audit log on startup instead of the
+	// provider, on top of the snapshot when there is one
+	Replay bool
+	// Snapshot is the file the state of the rates is saved to and restored
+	// from on startup, empty keeps no snapshot
+	Snapshot string
+	// SnapshotInterval is how often the snapshot is written
+	SnapshotInterval time.Duration
+	// HistorySeeds are ECB history files (.xml, .csv or .zip) recorded in the
+	// history on startup
+	HistorySeeds []string
+
+	// Spread is the default relative spread between bid and ask, e.g. 0.002
+	Spread float64
+	// CurrencySpreads overrides the spread per currency, e.g. "JPY=0.004,TRY=0.01"
+	CurrencySpreads string
+	// PairSpreads overrides the spread per pair, e.g. "USD/GBP=0.001"
+	PairSpreads string
+	// TierMultipliers scales the spread per customer tier, e.g. "RETAIL=1.5,WHOLESALE=0.5"
+	TierMultipliers string
+
+	// UnitSources load non-ISO units, each in the form PIVOT:provider:argument
+	// where provider is file (argument is the path) or fixed (argument is the
+	// table), e.g. "USD:file:crypto.json" or "EUR:fixed:PTS=100"
+	UnitSources []string
+	// UnitDigits sets the precision of units, e.g. "PTS=0,ETH=18"
+	UnitDigits string
+}
+
+// stringList is a flag that can be repeated
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, " ")
+}
+
+func (l *stringList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
+// Load parses the command line arguments into a Config
+func Load(args []string) (*Config, error) {
+	c := &Config{}
+
+	fs := flag.NewFlagSet("currency", flag.ContinueOnError)
+	fs.StringVar(&c.Addr, "addr", ":9092", "gRPC listen address")
+	fs.StringVar(&c.HTTPAddr, "http-addr", ":9093", "HTTP/JSON gateway listen address, empty disables the gateway")
+	fs.StringVar(&c.AdminAddr, "admin-addr", "127.0.0.1:9094", "Admin service listen address, empty disables the service")
+	fs.StringVar(&c.MetricsAddr, "metrics-addr", ":9095", "listen address of the Prometheus metrics endpoint, empty disables it")
+	fs.StringVar(&c.Provider, "provider", "ecb", "rate providers: ecb, file or fixed, e.g. ecb,file falls back to the file when the ECB fails")
+	fs.DurationVar(&c.ProviderTimeout, "provider-timeout", 10*time.Second, "timeout of a single request to a provider")
+	fs.IntVar(&c.ProviderRetries, "provider-retries", 2, "retries of a failed provider before the next provider is used")
+	fs.DurationVar(&c.ProviderBackoff, "provider-backoff", time.Second, "wait before the first retry of a provider, doubled with every retry")
+	fs.IntVar(&c.BreakerThreshold, "breaker-threshold", 3, "failures in a row after which a provider is skipped, 0 never skips it")
+	fs.DurationVar(&c.BreakerCooldown, "breaker-cooldown", 5*time.Minute, "how long a failing provider is skipped")
+	fs.DurationVar(&c.RefreshInterval, "refresh-interval", time.Hour, "how often rates are reloaded from the providers, 0 loads them once")
+	fs.StringVar(&c.ECBURL, "ecb-url", "", "URL of the ECB daily rates feed")
+	fs.StringVar(&c.RatesFile, "rates-file", "", "rates file used by the file provider")
+	fs.StringVar(&c.FixedRates, "fixed-rates", "", "rates used by the fixed provider, e.g. USD=1.09,GBP=0.86 or USD/JPY=147.2")
+	fs.StringVar(&c.ProviderBase, "provider-base", "EUR", "currency the rates of the file and fixed providers are quoted against")
+	fs.StringVar(&c.Pivot, "pivot", "EUR", "currency cross rates and the history are quoted through")
+
+	fs.DurationVar(&c.UpdateInterval, "update-interval", 5*time.Second, "how often rates are pushed to subscribers")
+	fs.IntVar(&c.QueueSize, "queue-size", 64, "messages queued per subscriber, replies included")
+	fs.StringVar(&c.OverflowPolicy, "overflow-policy", "drop-oldest", "what to do when a subscriber queue is full: drop-oldest, coalesce or disconnect")
+	fs.BoolVar(&c.Simulate, "simulate", true, "simulate fluctuations of the rates")
+	fs.StringVar(&c.SimModel, "sim-model", "random-walk", "simulation model: random-walk, mean-reversion or gbm")
+	fs.Float64Var(&c.SimParam, "sim-param", 0.1, "bound of the random walk, reversion speed or drift of the model")
+	fs.Float64Var(&c.SimVolatility, "sim-volatility", 0.01, "relative volatility per update")
+	fs.StringVar(&c.SimCurrencyVolatility, "sim-currency-volatility", "", "volatility per currency, e.g. JPY=0.02,GBP=0.005")
+	fs.Int64Var(&c.SimSeed, "sim-seed", 0, "seed of the simulation, 0 picks a random seed")
+	fs.DurationVar(&c.MaxRateAge, "max-rate-age", 0, "age after which rates are refused as stale, 0 allows any age")
+	fs.DurationVar(&c.HealthStaleAfter, "health-stale-after", 3*time.Hour, "age of the last successful refresh from the provider after which the health service reports NOT_SERVING, 0 never does")
+	fs.DurationVar(&c.HistoryRetention, "history-retention", 24*time.Hour, "how long rate history is kept, 0 keeps everything")
+	fs.StringVar(&c.AuditLog, "audit-log", "", "file every change of the quotes is appended to, rotated to its .prev file with every snapshot, empty keeps no audit log")
+	fs.DurationVar(&c.AuditSync, "audit-sync", time.Second, "how often the appended audit log entries are synced to disk, 0 syncs every change")
+	fs.BoolVar(&c.Replay, "replay", false, "rebuild the rates and their history from the audit log, on top of the snapshot if any, on startup instead of the provider")
+	fs.StringVar(&c.Snapshot, "snapshot", "", "file the rates are saved to and restored from on startup until the provider is reached, empty keeps no snapshot")
+	fs.DurationVar(&c.SnapshotInterval, "snapshot-interval", time.Minute, "how often the snapshot of the rates is written")
+	fs.Var((*stringList)(&c.HistorySeeds), "history-seed", "ECB history file (.xml, .csv or .zip) seeding the rate history, seeded days are kept regardless of -history-retention, can be repeated")
+	fs.Float64Var(&c.Spread, "spread", 0, "default relative spread between bid and ask")
+	fs.StringVar(&c.CurrencySpreads, "currency-spreads", "", "spread per currency, e.g. JPY=0.004,TRY=0.01")
+	fs.StringVar(&c.PairSpreads, "pair-spreads", "", "spread per pair, e.g. USD/GBP=0.001")
+	fs.StringVar(&c.TierMultipliers, "tier-multipliers", "", "spread multiplier per customer tier, e.g. RETAIL=1.5,WHOLESALE=0.5")
+	fs.Var((*stringList)(&c.UnitSources), "unit-source", "source of non-ISO units as PIVOT:file:path or PIVOT:fixed:table, can be repeated")
+	fs.StringVar(&c.UnitDigits, "unit-digits", "", "precision of units, e.g. PTS=0,ETH=18")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ProviderBase = strings.ToUpper(c.ProviderBase)
+	c.Pivot = strings.ToUpper(c.Pivot)
+
+	seen := map[string]bool{}
+	for _, p := range strings.Split(c.Provider, ",") {
+		p = strings.TrimSpace(p)
+		if seen[p] {
+			return nil, fmt.Errorf("rate provider %q is listed twice", p)
+		}
+		seen[p] = true
+
+		switch p {
+		case "ecb", "fixed":
+		case "file":
+			if c.RatesFile == "" {
+				return nil, fmt.Errorf("the file provider requires -rates-file")
+			}
+		default:
+			return nil, fmt.Errorf("unknown rate provider %q", p)
+		}
+
+		c.Providers = append(c.Providers, p)
+	}
+
+	// the base applies to every provider of the chain
+	if seen["ecb"] && c.ProviderBase != "EUR" {
+		return nil, fmt.Errorf("the ecb provider quotes against EUR, got -provider-base %s", c.ProviderBase)
+	}
+
+	if c.ProviderRetries < 0 || c.BreakerThreshold < 0 {
+		return nil, fmt.Errorf("-provider-retries and -breaker-threshold must not be negative")
+	}
+
+	for _, us := range c.UnitSources {
+		parts := strings.SplitN(us, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid unit source %q, expected PIVOT:provider:argument", us)
+		}
+
+		if parts[1] != "file" && parts[1] != "fixed" {
+			return nil, fmt.Errorf("unknown unit provider %q", parts[1])
+		}
+	}
+
+	if c.Replay && c.AuditLog == "" {
+		return nil, fmt.Errorf("-replay requires -audit-log")
+	}
+
+	if c.AuditSync < 0 {
+		return nil, fmt.Errorf("-audit-sync must not be negative, got %s", c.AuditSync)
+	}
+
+	if c.Snapshot != "" && c.SnapshotInterval <= 0 {
+		return nil, fmt.Errorf("snapshot interval must be positive, got %s", c.SnapshotInterval)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,407 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoAuditLog is returned when the rate store keeps no audit log
+var ErrNoAuditLog = errors.New("audit log is disabled")
+
+// Causes of the changes recorded in the audit log
+const (
+	CauseRefresh         = "refresh"
+	CauseSimulator       = "simulator"
+	CauseUnits           = "units"
+	CauseOverride        = "override"
+	CauseOverrideCleared = "override-cleared"
+	CauseOverrideExpired = "override-expired"
+)
+
+// AuditEntry is a change of a single quote or override of the rate store.
+// Old is zero for a new quote and New is zero for a removed quote or override
+type AuditEntry struct {
+	Time     time.Time `json:"time"`
+	Sequence uint64    `json:"sequence"`
+	Pair     string    `json:"pair"`
+	Old      float64   `json:"old"`
+	New      float64   `json:"new"`
+	Cause    string    `json:"cause"`
+	Source   string    `json:"source"`
+	// Expires is the expiry of an override set with a ttl
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+// override reports whether the entry changes an override rather than a quote
+func (a AuditEntry) override() bool {
+	return strings.HasPrefix(a.Cause, CauseOverride)
+}
+
+// auditIndexStride is the number of entries between two marks of the seek
+// index of an audit log
+const auditIndexStride = 1024
+
+// auditMark is the time of an entry of the audit log and its offset in the file
+type auditMark struct {
+	time   time.Time
+	offset int64
+}
+
+// AuditLog appends the changes of the rate store to a file, one JSON
+// entry per line. The file is only appended to and the entries are in the
+// order of their time until it is rotated to path.prev
+type AuditLog struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	// size is the offset of the next entry
+	size int64
+	// index marks every auditIndexStride-th entry, queries seek to the last
+	// mark before their start
+	index   []auditMark
+	pending int
+
+	// syncEvery batches the syncs to disk, zero syncs on every append
+	syncEvery time.Duration
+	dirty     bool
+	timer     *time.Timer
+}
+
+// OpenAuditLog opens the audit log at path for appending, creating it if
+// needed. The appended entries are synced to disk at most every syncEvery,
+// zero syncs them as they are appended
+func OpenAuditLog(path string, syncEvery time.Duration) (*AuditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &AuditLog{path: path, f: f, syncEvery: syncEvery}
+
+	// an invalid entry ends the index, queries past it fail as they read it
+	scanAuditLog(f, 0, func(e AuditEntry, off int64) bool {
+		a.mark(e.Time, off)
+		return true
+	})
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	a.size = fi.Size()
+
+	return a, nil
+}
+
+// mark counts an entry written at offset and adds it to the index when it is due
+func (a *AuditLog) mark(t time.Time, offset int64) {
+	if a.pending == 0 {
+		a.index = append(a.index, auditMark{t, offset})
+	}
+	a.pending = (a.pending + 1) % auditIndexStride
+}
+
+// Append writes the entries, they are synced to disk at once or with the
+// next batch
+func (a *AuditLog) Append(entries []AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var b bytes.Buffer
+	offsets := make([]int, len(entries))
+	enc := json.NewEncoder(&b)
+	for i, e := range entries {
+		offsets[i] = b.Len()
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// a single write keeps the entries of a change together
+	n, err := a.f.Write(b.Bytes())
+	if err != nil {
+		a.size += int64(n)
+		return err
+	}
+
+	for i, e := range entries {
+		a.mark(e.Time, a.size+int64(offsets[i]))
+	}
+	a.size += int64(n)
+
+	if a.syncEvery <= 0 {
+		return a.f.Sync()
+	}
+
+	a.dirty = true
+	if a.timer == nil {
+		a.timer = time.AfterFunc(a.syncEvery, a.flush)
+	}
+
+	return nil
+}
+
+// flush syncs the entries appended since the last sync
+func (a *AuditLog) flush() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.timer = nil
+	if a.dirty {
+		a.dirty = false
+		a.f.Sync()
+	}
+}
+
+// Query returns the entries between start and end of the quotes that
+// involve any of the codes, oldest first, since the last rotation. A zero
+// start or end leaves the range open and no codes match every quote. The
+// entries before start are skipped using the index and reading stops at end
+func (a *AuditLog) Query(codes []string, start, end time.Time) ([]AuditEntry, error) {
+	a.mu.Lock()
+	offset, size := int64(0), a.size
+	i := sort.Search(len(a.index), func(i int) bool { return !a.index[i].time.Before(start) })
+	if i > 0 {
+		offset = a.index[i-1].offset
+	}
+	a.mu.Unlock()
+
+	f, err := os.Open(a.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	match := make(map[string]bool, len(codes))
+	for _, c := range codes {
+		match[c] = true
+	}
+
+	res := []AuditEntry{}
+	err = scanAuditLog(io.NewSectionReader(f, offset, size-offset), offset, func(e AuditEntry, _ int64) bool {
+		if !end.IsZero() && e.Time.After(end) {
+			return false
+		}
+
+		if !start.IsZero() && e.Time.Before(start) {
+			return true
+		}
+
+		if b, d := splitPair(e.Pair); len(match) > 0 && !match[b] && !match[d] {
+			return true
+		}
+
+		res = append(res, e)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Rotate moves the entries to path.prev, replacing the entries rotated
+// before, and starts an empty file. The rate store rotates its log when it
+// writes a snapshot, the snapshot holds the rotated changes
+func (a *AuditLog) Rotate() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.dirty {
+		a.dirty = false
+		if err := a.f.Sync(); err != nil {
+			return err
+		}
+	}
+
+	err := os.Rename(a.path, a.path+".prev")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+
+	a.f.Close()
+	a.f, a.size, a.index, a.pending = f, 0, nil, 0
+
+	return nil
+}
+
+// Close syncs the pending entries and closes the file of the audit log
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.timer != nil {
+		a.timer.Stop()
+		a.timer = nil
+	}
+
+	if a.dirty {
+		a.dirty = false
+		if err := a.f.Sync(); err != nil {
+			a.f.Close()
+			return err
+		}
+	}
+
+	return a.f.Close()
+}
+
+// ReadAuditLog reads every entry of the audit log at path, the entries
+// rotated to path.prev come first. A last line without a newline is an
+// entry still being written and is skipped
+func ReadAuditLog(path string) ([]AuditEntry, error) {
+	var entries []AuditEntry
+	for _, p := range []string{path + ".prev", path} {
+		f, err := os.Open(p)
+		if p != path && errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = scanAuditLog(f, 0, func(e AuditEntry, _ int64) bool {
+			entries = append(entries, e)
+			return true
+		})
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+	}
+
+	return entries, nil
+}
+
+// scanAuditLog calls fn with every entry read from r and its offset in the
+// file until fn returns false, r starts at offset. A last line without a
+// newline is skipped
+func scanAuditLog(r io.Reader, offset int64, fn func(e AuditEntry, offset int64) bool) error {
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var e AuditEntry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return fmt.Errorf("invalid audit entry at offset %d: %w", offset, err)
+		}
+
+		if !fn(e, offset) {
+			return nil
+		}
+		offset += int64(len(line))
+	}
+}
+
+// auditEntries returns the changes of the quotes and overrides from one set to the next
+func auditEntries(old, rs *rateSet, cause string, now time.Time) []AuditEntry {
+	var entries []AuditEntry
+	for k, v := range rs.quotes {
+		if o := old.quotes[k]; o != v {
+			entries = append(entries, AuditEntry{Time: now, Sequence: rs.sequence, Pair: k, Old: o, New: v, Cause: cause, Source: rs.sources[k]})
+		}
+	}
+
+	for k, o := range old.quotes {
+		if _, ok := rs.quotes[k]; !ok {
+			entries = append(entries, AuditEntry{Time: now, Sequence: rs.sequence, Pair: k, Old: o, Cause: cause, Source: old.sources[k]})
+		}
+	}
+
+	for k, o := range rs.overrides {
+		if p, ok := old.overrides[k]; !ok || p != o {
+			e := AuditEntry{Time: now, Sequence: rs.sequence, Pair: k, Old: p.Rate, New: o.Rate, Cause: cause, Source: OverrideSource}
+			if !o.Expires.IsZero() {
+				exp := o.Expires
+				e.Expires = &exp
+			}
+
+			entries = append(entries, e)
+		}
+	}
+
+	for k, p := range old.overrides {
+		if _, ok := rs.overrides[k]; !ok {
+			entries = append(entries, AuditEntry{Time: now, Sequence: rs.sequence, Pair: k, Old: p.Rate, Cause: cause, Source: OverrideSource})
+		}
+	}
+
+	// entries of the same change are written in a stable order
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Pair < entries[j].Pair })
+
+	return entries
+}
+
+// replay applies the entries of the audit log to the set and records the
+// rates after every change in the history, the units are rebuilt from the
+// entries of the unit sources
+func (rs *rateSet) replay(entries []AuditEntry, h *History) {
+	for i, e := range entries {
+		if e.override() {
+			if e.New == 0 {
+				delete(rs.overrides, e.Pair)
+			} else {
+				b, d := splitPair(e.Pair)
+				o := Override{Base: b, Dest: d, Rate: e.New, Set: e.Time}
+				if e.Expires != nil {
+					o.Expires = *e.Expires
+				}
+				rs.overrides[e.Pair] = o
+			}
+		} else if e.New == 0 {
+			delete(rs.quotes, e.Pair)
+			delete(rs.updated, e.Pair)
+			delete(rs.sources, e.Pair)
+			delete(rs.provided, e.Pair)
+		} else {
+			rs.quotes[e.Pair] = e.New
+			rs.updated[e.Pair] = e.Time
+			rs.sources[e.Pair] = e.Source
+
+			switch e.Cause {
+			case CauseRefresh:
+				rs.provided[e.Pair] = e.New
+			case CauseUnits:
+				// the entries do not describe the units, the unit sources
+				// describe them again when they are added
+				p, code := splitPair(e.Pair)
+				rs.units[code] = unit{Info: unitInfo(code, nil), Pivot: p}
+			}
+		}
+
+		rs.sequence = e.Sequence
+
+		// the entries of a change share its sequence
+		if i == len(entries)-1 || entries[i+1].Sequence != e.Sequence {
+			rs.derive()
+			h.Record(e.Time, rs.rates)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package data
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func newAuditedRates(t *testing.T, path string) *ExchangeRates {
+	t.Helper()
+
+	a, err := OpenAuditLog(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { a.Close() })
+
+	tr := NewRates(hclog.NewNullLogger(), NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160}), "EUR", NewHistory(0), nil, a)
+	t.Cleanup(tr.Close)
+
+	return tr
+}
+
+func TestAuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	tr := newAuditedRates(t, path)
+
+	_, err := tr.SetOverride("EUR", "USD", 2, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tr.ClearOverride("EUR", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ReadAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []AuditEntry{
+		{Pair: "EUR/JPY", New: 160, Cause: CauseRefresh, Source: "fixed", Sequence: 1},
+		{Pair: "EUR/USD", New: 1.1, Cause: CauseRefresh, Source: "fixed", Sequence: 1},
+		{Pair: "EUR/USD", New: 2, Cause: CauseOverride, Source: OverrideSource, Sequence: 2},
+		{Pair: "EUR/USD", Old: 2, Cause: CauseOverrideCleared, Source: OverrideSource, Sequence: 3},
+	}
+
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %#v", len(want), entries)
+	}
+
+	for i, w := range want {
+		e := entries[i]
+		if e.Pair != w.Pair || e.Old != w.Old || e.New != w.New || e.Cause != w.Cause || e.Source != w.Source || e.Sequence != w.Sequence {
+			t.Errorf("entry %d: expected %#v, got %#v", i, w, e)
+		}
+	}
+
+	if entries[2].Expires == nil {
+		t.Errorf("expected the expiry of the override to be recorded")
+	}
+
+	// a refresh without changes records nothing
+	err = tr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	es, err := tr.AuditEntries([]string{"JPY"}, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(es) != 1 || es[0].Pair != "EUR/JPY" {
+		t.Fatalf("expected the EUR/JPY entry, got %#v", es)
+	}
+}
+
+func TestReplayRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	tr := newAuditedRates(t, path)
+
+	_, err := tr.SetOverride("USD", "JPY", 150, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tr.SetOverride("EUR", "USD", 1.2, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, _ := tr.GetRate("USD", "JPY")
+
+	entries, err := ReadAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the EUR/USD override expires before the replay
+	time.Sleep(5 * time.Millisecond)
+
+	h := NewHistory(0)
+	rr, err := ReplayRates(hclog.NewNullLogger(), NewFixedProvider(nil), "EUR", h, nil, nil, nil, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := rr.GetRate("USD", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Value != want.Value || got.Source != OverrideSource {
+		t.Fatalf("expected the replayed override %#v, got %#v", want, got)
+	}
+
+	if os := rr.Overrides(); len(os) != 1 || os[0].Base != "USD" {
+		t.Fatalf("expected only the USD/JPY override to remain, got %#v", os)
+	}
+
+	r, _ := rr.GetRate("EUR", "USD")
+	if r.Value != 1.1 || r.Source != "fixed" {
+		t.Fatalf("expected the provider rate after the expired override, got %#v", r)
+	}
+
+	// the history holds the rates after every replayed change
+	p, err := h.At("EUR", "USD", entries[len(entries)-1].Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Rate != 1.2 {
+		t.Fatalf("expected the overridden rate in the history, got %#v", p)
+	}
+
+	// the replayed rates count as loaded at the last entry
+	if st := rr.RefreshStatus(); !st.Loaded().Equal(entries[len(entries)-1].Time) {
+		t.Fatalf("expected the rates loaded at the last entry, got %#v", st)
+	}
+}
+
+func TestReplayRatesEmpty(t *testing.T) {
+	_, err := ReplayRates(hclog.NewNullLogger(), NewFixedProvider(nil), "EUR", NewHistory(0), nil, nil, nil, nil)
+	if err == nil {
+		t.Fatal("expected an error without entries")
+	}
+}
+
+func TestAuditLogQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	a, err := OpenAuditLog(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 3*auditIndexStride; i++ {
+		err := a.Append([]AuditEntry{
+			{Time: t0.Add(time.Duration(i) * time.Second), Sequence: uint64(i + 1), Pair: "EUR/GBP", New: float64(i), Cause: CauseRefresh},
+			{Time: t0.Add(time.Duration(i) * time.Second), Sequence: uint64(i + 1), Pair: "EUR/USD", New: float64(i), Cause: CauseRefresh},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(a.index) != 6 || !a.dirty {
+		t.Fatalf("expected 6 index marks and a pending sync, got %d marks", len(a.index))
+	}
+
+	// the pending entries are synced when the log is closed
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the index is rebuilt from the file
+	a, err = OpenAuditLog(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { a.Close() })
+
+	if len(a.index) != 6 {
+		t.Fatalf("expected 6 index marks, got %d", len(a.index))
+	}
+
+	start, end := t0.Add(2000*time.Second), t0.Add(2009*time.Second)
+	entries, err := a.Query([]string{"USD"}, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 10 || entries[0].New != 2000 || entries[9].New != 2009 || entries[0].Pair != "EUR/USD" {
+		t.Fatalf("expected the 10 EUR/USD entries from 2000 to 2009, got %#v", entries)
+	}
+
+	all, err := a.Query(nil, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all) != 6*auditIndexStride {
+		t.Fatalf("expected every entry for an open range, got %d", len(all))
+	}
+}
+
+func TestReplayUnits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	tr := newAuditedRates(t, path)
+
+	err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0.00002}), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ReadAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr, err := ReplayRates(hclog.NewNullLogger(), NewFixedProvider(nil), "EUR", NewHistory(0), nil, nil, nil, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ci, ok := rr.Lookup("BTC"); !ok || ci.Name != "Bitcoin" {
+		t.Fatalf("expected the BTC unit to be replayed, got %#v", ci)
+	}
+
+	// adding the units again records no change
+	err = rr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0.00002}), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again, _ := ReadAuditLog(path); len(again) != len(entries) {
+		t.Fatalf("expected no new entry, got %#v", again[len(entries):])
+	}
+}
+
+func TestAuditLogRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	tr := newAuditedRates(t, path)
+
+	_, err := tr.SetOverride("USD", "JPY", 150, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the snapshot holds the rotated entries
+	s := tr.Snapshot()
+	err = tr.audit.Rotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tr.SetOverride("EUR", "USD", 1.2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ReadAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 4 {
+		t.Fatalf("expected the rotated and the new entries, got %#v", entries)
+	}
+
+	rr, err := ReplayRates(hclog.NewNullLogger(), NewFixedProvider(nil), "EUR", NewHistory(0), nil, nil, s, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if os := rr.Overrides(); len(os) != 2 {
+		t.Fatalf("expected the overrides of the snapshot and of the log, got %#v", os)
+	}
+
+	// a second rotation drops the first entries
+	err = tr.audit.Rotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err = ReadAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].Pair != "EUR/USD" {
+		t.Fatalf("expected the entry of the EUR/USD override, got %#v", entries)
+	}
+
+	es, err := tr.AuditEntries(nil, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(es) != 0 {
+		t.Fatalf("expected no entry since the rotation, got %#v", es)
+	}
+}
@@ -0,0 +1,44 @@
+package data
+
+import "time"
+
+// Candle holds the open, high, low and close rate of the updates within
+// an interval starting at Start
+type Candle struct {
+	Start time.Time
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+	// Ticks is the number of rate updates in the interval
+	Ticks int
+}
+
+// Aggregate groups the points into candles of the given interval, candles
+// are aligned to multiples of the interval in UTC and intervals without
+// points are skipped. The points must be sorted by time
+func Aggregate(points []RatePoint, interval time.Duration) []Candle {
+	candles := []Candle{}
+
+	for _, p := range points {
+		start := p.Time.UTC().Truncate(interval)
+
+		n := len(candles)
+		if n == 0 || !candles[n-1].Start.Equal(start) {
+			candles = append(candles, Candle{Start: start, Open: p.Rate, High: p.Rate, Low: p.Rate, Close: p.Rate, Ticks: 1})
+			continue
+		}
+
+		c := &candles[n-1]
+		if p.Rate > c.High {
+			c.High = p.Rate
+		}
+		if p.Rate < c.Low {
+			c.Low = p.Rate
+		}
+		c.Close = p.Rate
+		c.Ticks++
+	}
+
+	return candles
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregate(t *testing.T) {
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	points := []RatePoint{
+		{t0.Add(10 * time.Second), 1.10},
+		{t0.Add(20 * time.Second), 1.12},
+		{t0.Add(30 * time.Second), 1.08},
+		{t0.Add(50 * time.Second), 1.09},
+		{t0.Add(3*time.Minute + 5*time.Second), 1.11},
+	}
+
+	candles := Aggregate(points, time.Minute)
+	if len(candles) != 2 {
+		t.Fatalf("expected 2 candles, got %#v", candles)
+	}
+
+	want := Candle{Start: t0, Open: 1.10, High: 1.12, Low: 1.08, Close: 1.09, Ticks: 4}
+	if candles[0] != want {
+		t.Fatalf("expected %#v, got %#v", want, candles[0])
+	}
+
+	if !candles[1].Start.Equal(t0.Add(3*time.Minute)) || candles[1].Open != 1.11 || candles[1].Ticks != 1 {
+		t.Fatalf("unexpected second candle %#v", candles[1])
+	}
+
+	if len(Aggregate(points, 24*time.Hour)) != 1 {
+		t.Fatal("expected every point in one daily candle")
+	}
+}
+
+func TestGetCandles(t *testing.T) {
+	h := NewHistory(0)
+	t0 := time.Date(2023, 5, 12, 0, 0, 0, 0, time.UTC)
+
+	h.Record(t0.Add(time.Hour), map[string]float64{"EUR": 1, "USD": 1.1})
+	h.Record(t0.Add(2*time.Hour), map[string]float64{"EUR": 1, "USD": 1.2})
+	h.Record(t0.Add(26*time.Hour), map[string]float64{"EUR": 1, "USD": 1.0})
+
+	er := &ExchangeRates{history: h}
+
+	candles, err := er.GetCandles("EUR", "USD", t0, t0.Add(48*time.Hour), 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(candles) != 2 || candles[0].High != 1.2 || candles[0].Close != 1.2 || candles[1].Open != 1.0 {
+		t.Fatalf("unexpected daily candles %#v", candles)
+	}
+}
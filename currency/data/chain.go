@@ -0,0 +1,278 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrProvidersFailed is returned when no provider of a chain served rates
+var ErrProvidersFailed = errors.New("every rate provider failed")
+
+// BreakerState is the state of the circuit breaker of a provider
+type BreakerState int
+
+const (
+	// BreakerClosed lets every request through
+	BreakerClosed BreakerState = iota
+	// BreakerOpen skips the provider until the cooldown elapses
+	BreakerOpen
+	// BreakerHalfOpen lets a single trial request through, its outcome
+	// closes or opens the breaker again
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+
+	return "closed"
+}
+
+// ChainPolicy configures how a chain requests its providers
+type ChainPolicy struct {
+	// Timeout bounds a single request to a provider, 0 waits indefinitely
+	Timeout time.Duration
+	// Retries is the number of retries of a provider after a failed request
+	Retries int
+	// Backoff is the wait before the first retry, it doubles with every retry
+	Backoff time.Duration
+	// Threshold is the number of consecutive failures that opens the breaker
+	// of a provider, 0 never opens it
+	Threshold int
+	// Cooldown is how long an open breaker skips its provider
+	Cooldown time.Duration
+}
+
+// ProviderHealth describes the recent requests to a provider of a chain.
+// A failure is a fetch whose request and retries all failed
+type ProviderHealth struct {
+	Name                string
+	State               BreakerState
+	ConsecutiveFailures int
+	// Requests and Failures count every request including retries
+	Requests    int64
+	Failures    int64
+	LastSuccess time.Time
+	LastFailure time.Time
+	LastError   string
+	// OpenUntil is when an open breaker lets a trial request through
+	OpenUntil time.Time
+}
+
+// ProviderChain is a provider that requests an ordered list of providers,
+// the rates of the first provider that succeeds are used
+type ProviderChain struct {
+	providers []RateProvider
+	policy    ChainPolicy
+
+	mu     sync.Mutex
+	health []ProviderHealth
+	// trials marks the providers whose half-open breaker has a trial request in flight
+	trials []bool
+	// observe is called after every request to a provider
+	observe func(provider string, d time.Duration, err error)
+}
+
+func NewProviderChain(policy ChainPolicy, providers ...RateProvider) *ProviderChain {
+	h := make([]ProviderHealth, len(providers))
+	for i, p := range providers {
+		h[i].Name = p.Name()
+	}
+
+	return &ProviderChain{providers: providers, policy: policy, health: h, trials: make([]bool, len(providers))}
+}
+
+// Name returns the names of the providers in order
+func (c *ProviderChain) Name() string {
+	names := make([]string, len(c.providers))
+	for i, p := range c.providers {
+		names[i] = p.Name()
+	}
+
+	return strings.Join(names, ",")
+}
+
+func (c *ProviderChain) Rates() (map[string]float64, error) {
+	rates, _, err := c.Fetch()
+	return rates, err
+}
+
+// Fetch returns the rates of the first provider that succeeds and its name,
+// providers with an open breaker are skipped
+func (c *ProviderChain) Fetch() (map[string]float64, string, error) {
+	var errs []string
+	for i, p := range c.providers {
+		ok, trial := c.allow(i, time.Now())
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s: circuit %s", p.Name(), c.state(i)))
+			continue
+		}
+
+		// a trial request of a half-open breaker is not retried
+		retries := c.policy.Retries
+		if trial {
+			retries = 0
+		}
+
+		rates, err := c.try(i, retries)
+		c.report(i, err, time.Now())
+		if err == nil {
+			return rates, p.Name(), nil
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", p.Name(), err))
+	}
+
+	return nil, "", fmt.Errorf("%w: %s", ErrProvidersFailed, strings.Join(errs, "; "))
+}
+
+// ObserveRequests calls fn after every request to a provider, including
+// retries, with its latency and error. It must be set before the chain is used
+func (c *ProviderChain) ObserveRequests(fn func(provider string, d time.Duration, err error)) {
+	c.observe = fn
+}
+
+func (c *ProviderChain) state(i int) BreakerState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.health[i].State
+}
+
+// Health returns the health of every provider in order
+func (c *ProviderChain) Health() []ProviderHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]ProviderHealth(nil), c.health...)
+}
+
+// allow reports whether the provider may be requested and whether the
+// request is the trial of a half-open breaker, a half-open breaker lets a
+// single trial through until its outcome is reported
+func (c *ProviderChain) allow(i int, now time.Time) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h := &c.health[i]
+	if h.State == BreakerOpen {
+		if now.Before(h.OpenUntil) {
+			return false, false
+		}
+		h.State = BreakerHalfOpen
+	}
+
+	if h.State != BreakerHalfOpen {
+		return true, false
+	}
+
+	if c.trials[i] {
+		return false, false
+	}
+	c.trials[i] = true
+
+	return true, true
+}
+
+// try requests a provider until it succeeds or the retries run out
+func (c *ProviderChain) try(i, retries int) (map[string]float64, error) {
+	backoff := c.policy.Backoff
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		rates, err := c.request(c.providers[i])
+		if c.observe != nil {
+			c.observe(c.providers[i].Name(), time.Since(start), err)
+		}
+
+		c.mu.Lock()
+		c.health[i].Requests++
+		if err != nil {
+			c.health[i].Failures++
+		}
+		c.mu.Unlock()
+
+		if err == nil || attempt >= retries {
+			return rates, err
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// request calls the provider within the timeout of the policy, a request
+// that times out is abandoned. Rates that are empty or invalid fail the request
+func (c *ProviderChain) request(p RateProvider) (map[string]float64, error) {
+	type result struct {
+		rates map[string]float64
+		err   error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		rates, err := p.Rates()
+		ch <- result{rates, err}
+	}()
+
+	var timeout <-chan time.Time
+	if c.policy.Timeout > 0 {
+		t := time.NewTimer(c.policy.Timeout)
+		defer t.Stop()
+		timeout = t.C
+	}
+
+	var r result
+	select {
+	case r = <-ch:
+	case <-timeout:
+		return nil, fmt.Errorf("no response within %s", c.policy.Timeout)
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if len(r.rates) == 0 {
+		return nil, fmt.Errorf("no rates")
+	}
+
+	if _, err := providerQuotes(r.rates); err != nil {
+		return nil, err
+	}
+
+	return r.rates, nil
+}
+
+// report records the outcome of a fetch from a provider and opens its
+// breaker after too many consecutive failures or a failed trial
+func (c *ProviderChain) report(i int, err error, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.trials[i] = false
+
+	h := &c.health[i]
+	if err == nil {
+		h.State = BreakerClosed
+		h.OpenUntil = time.Time{}
+		h.ConsecutiveFailures = 0
+		h.LastSuccess = now
+		return
+	}
+
+	h.ConsecutiveFailures++
+	h.LastFailure = now
+	h.LastError = err.Error()
+
+	if h.State == BreakerHalfOpen || c.policy.Threshold > 0 && h.ConsecutiveFailures >= c.policy.Threshold {
+		h.State = BreakerOpen
+		h.OpenUntil = now.Add(c.policy.Cooldown)
+	}
+}
@@ -0,0 +1,262 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// flakyProvider fails while fail is set and counts its requests
+type flakyProvider struct {
+	name  string
+	rates map[string]float64
+	delay time.Duration
+
+	mu    sync.Mutex
+	fail  bool
+	calls int
+}
+
+func (p *flakyProvider) Name() string {
+	return p.name
+}
+
+func (p *flakyProvider) Rates() (map[string]float64, error) {
+	time.Sleep(p.delay)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls++
+	if p.fail {
+		return nil, errors.New("unavailable")
+	}
+
+	return p.rates, nil
+}
+
+func (p *flakyProvider) set(fail bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fail = fail
+}
+
+func (p *flakyProvider) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.calls
+}
+
+func TestProviderChainFallback(t *testing.T) {
+	primary := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.1}, fail: true}
+	backup := &flakyProvider{name: "backup", rates: map[string]float64{"USD": 1.2}}
+
+	c := NewProviderChain(ChainPolicy{Retries: 2, Backoff: time.Millisecond}, primary, backup)
+
+	var observed []string
+	c.ObserveRequests(func(provider string, d time.Duration, err error) {
+		observed = append(observed, fmt.Sprintf("%s:%t", provider, err == nil))
+	})
+
+	rates, name, err := c.Fetch()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name != "backup" || rates["USD"] != 1.2 {
+		t.Fatalf("expected the rates of the backup, got %s %v", name, rates)
+	}
+
+	// the request and its two retries
+	if primary.count() != 3 {
+		t.Fatalf("expected 3 requests to the primary, got %d", primary.count())
+	}
+
+	if got := strings.Join(observed, " "); got != "primary:false primary:false primary:false backup:true" {
+		t.Fatalf("expected every request to be observed, got %s", got)
+	}
+
+	h := c.Health()
+	if h[0].Requests != 3 || h[0].Failures != 3 || h[0].ConsecutiveFailures != 1 || h[0].LastError == "" {
+		t.Fatalf("expected the failures of the primary, got %#v", h[0])
+	}
+
+	if h[1].LastSuccess.IsZero() || h[1].Failures != 0 {
+		t.Fatalf("expected a success of the backup, got %#v", h[1])
+	}
+
+	backup.set(true)
+	_, _, err = c.Fetch()
+	if !errors.Is(err, ErrProvidersFailed) {
+		t.Fatalf("expected ErrProvidersFailed, got %v", err)
+	}
+}
+
+func TestProviderChainTimeout(t *testing.T) {
+	slow := &flakyProvider{name: "slow", rates: map[string]float64{"USD": 1.1}, delay: 100 * time.Millisecond}
+	fast := &flakyProvider{name: "fast", rates: map[string]float64{"USD": 1.2}}
+
+	c := NewProviderChain(ChainPolicy{Timeout: 5 * time.Millisecond}, slow, fast)
+
+	_, name, err := c.Fetch()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name != "fast" {
+		t.Fatalf("expected the slow provider to time out, got the rates of %s", name)
+	}
+}
+
+func TestProviderChainBreaker(t *testing.T) {
+	primary := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.1}, fail: true}
+	backup := &flakyProvider{name: "backup", rates: map[string]float64{"USD": 1.2}}
+
+	c := NewProviderChain(ChainPolicy{Threshold: 2, Cooldown: 20 * time.Millisecond}, primary, backup)
+
+	for i := 0; i < 3; i++ {
+		c.Fetch()
+	}
+
+	// the breaker opened after two failures, the third fetch skipped the primary
+	if primary.count() != 2 || c.Health()[0].State != BreakerOpen {
+		t.Fatalf("expected an open breaker after 2 requests, got %d requests and %#v", primary.count(), c.Health()[0])
+	}
+
+	// a failed trial opens the breaker again
+	time.Sleep(25 * time.Millisecond)
+	c.Fetch()
+	if primary.count() != 3 || c.Health()[0].State != BreakerOpen {
+		t.Fatalf("expected a failed trial, got %d requests and %#v", primary.count(), c.Health()[0])
+	}
+
+	// a successful trial closes the breaker
+	primary.set(false)
+	time.Sleep(25 * time.Millisecond)
+	_, name, _ := c.Fetch()
+	if name != "primary" || c.Health()[0].State != BreakerClosed || c.Health()[0].ConsecutiveFailures != 0 {
+		t.Fatalf("expected the primary to recover, got %s and %#v", name, c.Health()[0])
+	}
+}
+
+func TestProviderChainSingleTrial(t *testing.T) {
+	primary := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.1}, delay: 20 * time.Millisecond, fail: true}
+	backup := &flakyProvider{name: "backup", rates: map[string]float64{"USD": 1.2}}
+
+	c := NewProviderChain(ChainPolicy{Threshold: 1, Cooldown: 10 * time.Millisecond}, primary, backup)
+	c.Fetch()
+	time.Sleep(15 * time.Millisecond)
+
+	// concurrent fetches of a half-open breaker send a single trial
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Fetch()
+		}()
+	}
+	wg.Wait()
+
+	if primary.count() != 2 || c.Health()[0].State != BreakerOpen {
+		t.Fatalf("expected a single failed trial, got %d requests and %#v", primary.count(), c.Health()[0])
+	}
+}
+
+func TestRefreshKeepsLastRates(t *testing.T) {
+	p := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.1}}
+
+	tr := NewRates(hclog.NewNullLogger(), NewProviderChain(ChainPolicy{}, p), "EUR", NewHistory(0), nil, nil)
+
+	st := tr.RefreshStatus()
+	if st.Provider != "primary" || st.Err != nil || st.Time.IsZero() {
+		t.Fatalf("expected a refresh from the primary, got %#v", st)
+	}
+
+	p.set(true)
+	tr.RefreshRates(time.Millisecond)
+
+	deadline := time.Now().Add(time.Second)
+	for tr.RefreshStatus().Err == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("expected a failed refresh")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the last good rates are still served
+	r, err := tr.GetRate("EUR", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Value != 1.1 || r.Source != "primary" {
+		t.Fatalf("expected the last rates of the primary, got %#v", r)
+	}
+
+	if st := tr.RefreshStatus(); !st.Time.Equal(r.AsOf) || st.Provider != "primary" {
+		t.Fatalf("expected the last successful refresh to be kept, got %#v", st)
+	}
+}
+
+func TestNewRatesProviderDown(t *testing.T) {
+	p := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.1}, fail: true}
+
+	tr := NewRates(hclog.NewNullLogger(), NewProviderChain(ChainPolicy{}, p), "EUR", NewHistory(0), nil, nil)
+
+	if st := tr.RefreshStatus(); st.Err == nil || !st.Time.IsZero() {
+		t.Fatalf("expected a failed initial load, got %#v", st)
+	}
+
+	if _, err := tr.GetRate("EUR", "USD"); err == nil {
+		t.Fatal("expected no rates before the first load")
+	}
+
+	// units of an ISO pivot are accepted before the first load
+	err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0.00002}), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.set(false)
+	tr.RefreshRates(time.Millisecond)
+
+	deadline := time.Now().Add(time.Second)
+	for tr.RefreshStatus().Time.IsZero() {
+		if time.Now().After(deadline) {
+			t.Fatal("expected a refresh to load the rates")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	r, err := tr.GetRate("BTC", "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-50000/1.1) > 1e-6 {
+		t.Fatalf("expected %f EUR per BTC, got %#v", 50000/1.1, r)
+	}
+}
+
+func TestRefreshFrozen(t *testing.T) {
+	p := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.1}}
+
+	tr := NewRates(hclog.NewNullLogger(), p, "EUR", NewHistory(0), nil, nil)
+
+	tr.Freeze(true)
+	tr.RefreshRates(time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	if p.count() != 1 {
+		t.Fatalf("expected no refresh while frozen, got %d requests", p.count())
+	}
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ErrAmountOverflow is returned when a converted amount does not fit in Money
+var ErrAmountOverflow = fmt.Errorf("converted amount overflows")
+
+// RoundingMode selects how a converted amount is rounded to the minor unit
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest minor unit, ties go to the even neighbour
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest minor unit, ties go away from zero
+	RoundHalfUp
+	// RoundDown truncates towards zero
+	RoundDown
+)
+
+const nanosPerUnit = 1_000_000_000
+
+// Money is an amount of currency as whole units and nano (10^-9) units,
+// both parts must have the same sign
+type Money struct {
+	Units int64
+	Nanos int32
+}
+
+func (m Money) Validate() error {
+	if m.Nanos <= -nanosPerUnit || m.Nanos >= nanosPerUnit {
+		return fmt.Errorf("nanos %d out of range", m.Nanos)
+	}
+
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return fmt.Errorf("units %d and nanos %d must have the same sign", m.Units, m.Nanos)
+	}
+
+	return nil
+}
+
+func (m Money) rat() *big.Rat {
+	n := new(big.Int).Mul(big.NewInt(m.Units), big.NewInt(nanosPerUnit))
+	n.Add(n, big.NewInt(int64(m.Nanos)))
+
+	return new(big.Rat).SetFrac(n, big.NewInt(nanosPerUnit))
+}
+
+func (m Money) String() string {
+	sign := ""
+	units, nanos := m.Units, int64(m.Nanos)
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	return fmt.Sprintf("%s%d.%09d", sign, units, nanos)
+}
+
+// ConvertAmount multiplies the amount by the rate and rounds the result
+// to the given number of minor unit digits
+func ConvertAmount(amount Money, rate float64, digits int, mode RoundingMode) (Money, error) {
+	err := amount.Validate()
+	if err != nil {
+		return Money{}, err
+	}
+
+	if math.IsNaN(rate) || math.IsInf(rate, 0) || rate <= 0 {
+		return Money{}, fmt.Errorf("invalid rate %f", rate)
+	}
+
+	if digits < 0 || digits > 9 {
+		return Money{}, fmt.Errorf("unsupported number of minor unit digits %d", digits)
+	}
+
+	r := new(big.Rat).SetFloat64(rate)
+	v := new(big.Rat).Mul(amount.rat(), r)
+
+	// scale to minor units and round to an integer
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	v.Mul(v, new(big.Rat).SetInt(scale))
+	minor := round(v, mode)
+
+	units, rem := new(big.Int).QuoRem(minor, scale, new(big.Int))
+	if !units.IsInt64() {
+		return Money{}, ErrAmountOverflow
+	}
+
+	nanos := rem.Int64() * int64(math.Pow10(9-digits))
+
+	return Money{Units: units.Int64(), Nanos: int32(nanos)}, nil
+}
+
+// round rounds a rational number to an integer using the rounding mode
+func round(v *big.Rat, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	if r.Sign() == 0 || mode == RoundDown {
+		return q
+	}
+
+	step := big.NewInt(int64(v.Sign()))
+
+	// compare the discarded fraction with one half
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+
+	switch half.Cmp(v.Denom()) {
+	case 1:
+		q.Add(q, step)
+	case 0:
+		if mode == RoundHalfUp || q.Bit(0) == 1 {
+			q.Add(q, step)
+		}
+	}
+
+	return q
+}
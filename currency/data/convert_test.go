@@ -0,0 +1,63 @@
+package data
+
+import "testing"
+
+func TestConvertAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Money
+		rate   float64
+		digits int
+		mode   RoundingMode
+		want   Money
+	}{
+		{"half even rounds tie to even", Money{0, 125_000_000}, 1, 2, RoundHalfEven, Money{0, 120_000_000}},
+		{"half up rounds tie up", Money{0, 125_000_000}, 1, 2, RoundHalfUp, Money{0, 130_000_000}},
+		{"half up rounds tie away from zero", Money{2, 500_000_000}, 1, 0, RoundHalfUp, Money{3, 0}},
+		{"half even keeps even", Money{2, 500_000_000}, 1, 0, RoundHalfEven, Money{2, 0}},
+		{"half even rounds odd up", Money{3, 500_000_000}, 1, 0, RoundHalfEven, Money{4, 0}},
+		{"down truncates", Money{5, 990_000_000}, 1, 0, RoundDown, Money{5, 0}},
+		{"no fractional yen", Money{5, 990_000_000}, 147.15, 0, RoundHalfEven, Money{881, 0}},
+		{"three digit currency", Money{10, 0}, 0.4123456, 3, RoundHalfUp, Money{4, 123_000_000}},
+		{"negative half up", Money{-2, -500_000_000}, 1, 0, RoundHalfUp, Money{-3, 0}},
+		{"negative down", Money{-2, -700_000_000}, 1, 0, RoundDown, Money{-2, 0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertAmount(tc.amount, tc.rate, tc.digits, tc.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestConvertAmountInvalid(t *testing.T) {
+	_, err := ConvertAmount(Money{1, -5}, 1.1, 2, RoundHalfEven)
+	if err == nil {
+		t.Fatal("expected an error for mixed signs")
+	}
+
+	_, err = ConvertAmount(Money{1, 0}, 0, 2, RoundHalfEven)
+	if err == nil {
+		t.Fatal("expected an error for a zero rate")
+	}
+}
+
+func TestExchangeRatesConvert(t *testing.T) {
+	tr := newTestRates(t)
+
+	m, rate, err := tr.Convert(Money{10, 0}, "EUR", "JPY", "", SideMid, RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rate.Value != 160 || m != (Money{1600, 0}) {
+		t.Fatalf("unexpected conversion %s at %f", m, rate.Value)
+	}
+}
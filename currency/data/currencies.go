@@ -0,0 +1,176 @@
+package data
+
+// CurrencyInfo describes a currency as defined by ISO 4217
+type CurrencyInfo struct {
+	Code string
+	Name string
+	// Digits is the number of minor unit digits, e.g. 2 for cents
+	Digits int
+	Symbol string
+}
+
+// LookupCurrency returns the ISO 4217 description of a currency code
+func LookupCurrency(code string) (CurrencyInfo, bool) {
+	ci, ok := iso4217[code]
+	return ci, ok
+}
+
+// iso4217 holds the active ISO 4217 currency codes
+var iso4217 = map[string]CurrencyInfo{
+	"AED": {"AED", "UAE Dirham", 2, "د.إ"},
+	"AFN": {"AFN", "Afghani", 2, "؋"},
+	"ALL": {"ALL", "Lek", 2, "L"},
+	"AMD": {"AMD", "Armenian Dram", 2, "֏"},
+	"ANG": {"ANG", "Netherlands Antillean Guilder", 2, "ƒ"},
+	"AOA": {"AOA", "Kwanza", 2, "Kz"},
+	"ARS": {"ARS", "Argentine Peso", 2, "$"},
+	"AUD": {"AUD", "Australian Dollar", 2, "A$"},
+	"AWG": {"AWG", "Aruban Florin", 2, "ƒ"},
+	"AZN": {"AZN", "Azerbaijan Manat", 2, "₼"},
+	"BAM": {"BAM", "Convertible Mark", 2, "KM"},
+	"BBD": {"BBD", "Barbados Dollar", 2, "$"},
+	"BDT": {"BDT", "Taka", 2, "৳"},
+	"BGN": {"BGN", "Bulgarian Lev", 2, "лв"},
+	"BHD": {"BHD", "Bahraini Dinar", 3, "BD"},
+	"BIF": {"BIF", "Burundi Franc", 0, "FBu"},
+	"BMD": {"BMD", "Bermudian Dollar", 2, "$"},
+	"BND": {"BND", "Brunei Dollar", 2, "$"},
+	"BOB": {"BOB", "Boliviano", 2, "Bs."},
+	"BRL": {"BRL", "Brazilian Real", 2, "R$"},
+	"BSD": {"BSD", "Bahamian Dollar", 2, "$"},
+	"BTN": {"BTN", "Ngultrum", 2, "Nu."},
+	"BWP": {"BWP", "Pula", 2, "P"},
+	"BYN": {"BYN", "Belarusian Ruble", 2, "Br"},
+	"BZD": {"BZD", "Belize Dollar", 2, "$"},
+	"CAD": {"CAD", "Canadian Dollar", 2, "CA$"},
+	"CDF": {"CDF", "Congolese Franc", 2, "FC"},
+	"CHF": {"CHF", "Swiss Franc", 2, "CHF"},
+	"CLP": {"CLP", "Chilean Peso", 0, "$"},
+	"CNY": {"CNY", "Yuan Renminbi", 2, "¥"},
+	"COP": {"COP", "Colombian Peso", 2, "$"},
+	"CRC": {"CRC", "Costa Rican Colon", 2, "₡"},
+	"CUP": {"CUP", "Cuban Peso", 2, "$"},
+	"CVE": {"CVE", "Cabo Verde Escudo", 2, "$"},
+	"CZK": {"CZK", "Czech Koruna", 2, "Kč"},
+	"DJF": {"DJF", "Djibouti Franc", 0, "Fdj"},
+	"DKK": {"DKK", "Danish Krone", 2, "kr"},
+	"DOP": {"DOP", "Dominican Peso", 2, "$"},
+	"DZD": {"DZD", "Algerian Dinar", 2, "DA"},
+	"EGP": {"EGP", "Egyptian Pound", 2, "E£"},
+	"ERN": {"ERN", "Nakfa", 2, "Nfk"},
+	"ETB": {"ETB", "Ethiopian Birr", 2, "Br"},
+	"EUR": {"EUR", "Euro", 2, "€"},
+	"FJD": {"FJD", "Fiji Dollar", 2, "$"},
+	"FKP": {"FKP", "Falkland Islands Pound", 2, "£"},
+	"GBP": {"GBP", "Pound Sterling", 2, "£"},
+	"GEL": {"GEL", "Lari", 2, "₾"},
+	"GHS": {"GHS", "Ghana Cedi", 2, "GH₵"},
+	"GIP": {"GIP", "Gibraltar Pound", 2, "£"},
+	"GMD": {"GMD", "Dalasi", 2, "D"},
+	"GNF": {"GNF", "Guinean Franc", 0, "FG"},
+	"GTQ": {"GTQ", "Quetzal", 2, "Q"},
+	"GYD": {"GYD", "Guyana Dollar", 2, "$"},
+	"HKD": {"HKD", "Hong Kong Dollar", 2, "HK$"},
+	"HNL": {"HNL", "Lempira", 2, "L"},
+	"HRK": {"HRK", "Kuna", 2, "kn"},
+	"HTG": {"HTG", "Gourde", 2, "G"},
+	"HUF": {"HUF", "Forint", 2, "Ft"},
+	"IDR": {"IDR", "Rupiah", 2, "Rp"},
+	"ILS": {"ILS", "New Israeli Sheqel", 2, "₪"},
+	"INR": {"INR", "Indian Rupee", 2, "₹"},
+	"IQD": {"IQD", "Iraqi Dinar", 3, "ع.د"},
+	"IRR": {"IRR", "Iranian Rial", 2, "﷼"},
+	"ISK": {"ISK", "Iceland Krona", 0, "kr"},
+	"JMD": {"JMD", "Jamaican Dollar", 2, "$"},
+	"JOD": {"JOD", "Jordanian Dinar", 3, "JD"},
+	"JPY": {"JPY", "Yen", 0, "¥"},
+	"KES": {"KES", "Kenyan Shilling", 2, "KSh"},
+	"KGS": {"KGS", "Som", 2, "с"},
+	"KHR": {"KHR", "Riel", 2, "៛"},
+	"KMF": {"KMF", "Comorian Franc", 0, "CF"},
+	"KPW": {"KPW", "North Korean Won", 2, "₩"},
+	"KRW": {"KRW", "Won", 0, "₩"},
+	"KWD": {"KWD", "Kuwaiti Dinar", 3, "KD"},
+	"KYD": {"KYD", "Cayman Islands Dollar", 2, "$"},
+	"KZT": {"KZT", "Tenge", 2, "₸"},
+	"LAK": {"LAK", "Lao Kip", 2, "₭"},
+	"LBP": {"LBP", "Lebanese Pound", 2, "LL"},
+	"LKR": {"LKR", "Sri Lanka Rupee", 2, "Rs"},
+	"LRD": {"LRD", "Liberian Dollar", 2, "$"},
+	"LSL": {"LSL", "Loti", 2, "L"},
+	"LYD": {"LYD", "Libyan Dinar", 3, "LD"},
+	"MAD": {"MAD", "Moroccan Dirham", 2, "DH"},
+	"MDL": {"MDL", "Moldovan Leu", 2, "L"},
+	"MGA": {"MGA", "Malagasy Ariary", 2, "Ar"},
+	"MKD": {"MKD", "Denar", 2, "ден"},
+	"MMK": {"MMK", "Kyat", 2, "K"},
+	"MNT": {"MNT", "Tugrik", 2, "₮"},
+	"MOP": {"MOP", "Pataca", 2, "MOP$"},
+	"MRU": {"MRU", "Ouguiya", 2, "UM"},
+	"MUR": {"MUR", "Mauritius Rupee", 2, "₨"},
+	"MVR": {"MVR", "Rufiyaa", 2, "Rf"},
+	"MWK": {"MWK", "Malawi Kwacha", 2, "MK"},
+	"MXN": {"MXN", "Mexican Peso", 2, "MX$"},
+	"MYR": {"MYR", "Malaysian Ringgit", 2, "RM"},
+	"MZN": {"MZN", "Mozambique Metical", 2, "MT"},
+	"NAD": {"NAD", "Namibia Dollar", 2, "$"},
+	"NGN": {"NGN", "Naira", 2, "₦"},
+	"NIO": {"NIO", "Cordoba Oro", 2, "C$"},
+	"NOK": {"NOK", "Norwegian Krone", 2, "kr"},
+	"NPR": {"NPR", "Nepalese Rupee", 2, "Rs"},
+	"NZD": {"NZD", "New Zealand Dollar", 2, "NZ$"},
+	"OMR": {"OMR", "Rial Omani", 3, "RO"},
+	"PAB": {"PAB", "Balboa", 2, "B/."},
+	"PEN": {"PEN", "Sol", 2, "S/"},
+	"PGK": {"PGK", "Kina", 2, "K"},
+	"PHP": {"PHP", "Philippine Peso", 2, "₱"},
+	"PKR": {"PKR", "Pakistan Rupee", 2, "Rs"},
+	"PLN": {"PLN", "Zloty", 2, "zł"},
+	"PYG": {"PYG", "Guarani", 0, "₲"},
+	"QAR": {"QAR", "Qatari Rial", 2, "QR"},
+	"RON": {"RON", "Romanian Leu", 2, "lei"},
+	"RSD": {"RSD", "Serbian Dinar", 2, "дин."},
+	"RUB": {"RUB", "Russian Ruble", 2, "₽"},
+	"RWF": {"RWF", "Rwanda Franc", 0, "FRw"},
+	"SAR": {"SAR", "Saudi Riyal", 2, "SR"},
+	"SBD": {"SBD", "Solomon Islands Dollar", 2, "$"},
+	"SCR": {"SCR", "Seychelles Rupee", 2, "₨"},
+	"SDG": {"SDG", "Sudanese Pound", 2, "£"},
+	"SEK": {"SEK", "Swedish Krona", 2, "kr"},
+	"SGD": {"SGD", "Singapore Dollar", 2, "S$"},
+	"SHP": {"SHP", "Saint Helena Pound", 2, "£"},
+	"SLE": {"SLE", "Leone", 2, "Le"},
+	"SOS": {"SOS", "Somali Shilling", 2, "Sh"},
+	"SRD": {"SRD", "Surinam Dollar", 2, "$"},
+	"SSP": {"SSP", "South Sudanese Pound", 2, "£"},
+	"STN": {"STN", "Dobra", 2, "Db"},
+	"SVC": {"SVC", "El Salvador Colon", 2, "₡"},
+	"SYP": {"SYP", "Syrian Pound", 2, "£"},
+	"SZL": {"SZL", "Lilangeni", 2, "E"},
+	"THB": {"THB", "Baht", 2, "฿"},
+	"TJS": {"TJS", "Somoni", 2, "SM"},
+	"TMT": {"TMT", "Turkmenistan New Manat", 2, "m"},
+	"TND": {"TND", "Tunisian Dinar", 3, "DT"},
+	"TOP": {"TOP", "Pa'anga", 2, "T$"},
+	"TRY": {"TRY", "Turkish Lira", 2, "₺"},
+	"TTD": {"TTD", "Trinidad and Tobago Dollar", 2, "$"},
+	"TWD": {"TWD", "New Taiwan Dollar", 2, "NT$"},
+	"TZS": {"TZS", "Tanzanian Shilling", 2, "TSh"},
+	"UAH": {"UAH", "Hryvnia", 2, "₴"},
+	"UGX": {"UGX", "Uganda Shilling", 0, "USh"},
+	"USD": {"USD", "US Dollar", 2, "$"},
+	"UYU": {"UYU", "Peso Uruguayo", 2, "$"},
+	"UZS": {"UZS", "Uzbekistan Sum", 2, "soʻm"},
+	"VES": {"VES", "Bolivar Soberano", 2, "Bs.S"},
+	"VND": {"VND", "Dong", 0, "₫"},
+	"VUV": {"VUV", "Vatu", 0, "VT"},
+	"WST": {"WST", "Tala", 2, "T"},
+	"XAF": {"XAF", "CFA Franc BEAC", 0, "FCFA"},
+	"XCD": {"XCD", "East Caribbean Dollar", 2, "$"},
+	"XOF": {"XOF", "CFA Franc BCEAO", 0, "CFA"},
+	"XPF": {"XPF", "CFP Franc", 0, "₣"},
+	"YER": {"YER", "Yemeni Rial", 2, "﷼"},
+	"ZAR": {"ZAR", "Rand", 2, "R"},
+	"ZMW": {"ZMW", "Zambian Kwacha", 2, "ZK"},
+	"ZWL": {"ZWL", "Zimbabwe Dollar", 2, "$"},
+}
@@ -0,0 +1,227 @@
+package data
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ecbPublication is the time of day in UTC the reference rates of a day are
+// recorded at, the ECB publishes them at around 16:00 CET
+const ecbPublication = 15 * time.Hour
+
+// DailyRates are the EUR quoted reference rates of a day
+type DailyRates struct {
+	Date  time.Time
+	Rates map[string]float64
+}
+
+// HistoryCubes is the ECB 90-day and full-history document, it holds a
+// dated Cube per day. The daily document is a history of a single day
+type HistoryCubes struct {
+	Days []DayCube `xml:"Cube>Cube"`
+}
+
+// DayCube holds the rates of a day
+type DayCube struct {
+	Time  string `xml:"time,attr"`
+	Rates []Cube `xml:"Cube"`
+}
+
+// LoadECBHistory reads the reference rates of an ECB history file, the
+// format is chosen by the file extension: .xml, .csv or a .zip holding
+// either. The days are sorted oldest first
+func LoadECBHistory(path string) ([]DailyRates, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".zip":
+		return loadECBZip(path)
+	case ".xml", ".csv":
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return parseECBHistoryFile(path, f)
+	}
+
+	return nil, fmt.Errorf("unsupported history file format: %s", path)
+}
+
+// loadECBZip reads the first .xml or .csv file of a zip archive, the way
+// the ECB distributes its CSV files
+func loadECBZip(path string) ([]DailyRates, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		switch strings.ToLower(filepath.Ext(f.Name)) {
+		case ".xml", ".csv":
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+
+			return parseECBHistoryFile(f.Name, rc)
+		}
+	}
+
+	return nil, fmt.Errorf("no .xml or .csv file in %s", path)
+}
+
+func parseECBHistoryFile(name string, r io.Reader) ([]DailyRates, error) {
+	if strings.ToLower(filepath.Ext(name)) == ".xml" {
+		return ParseECBHistory(r)
+	}
+
+	return ParseECBHistoryCSV(r)
+}
+
+// ParseECBHistory parses the ECB daily, 90-day or full-history XML document
+func ParseECBHistory(r io.Reader) ([]DailyRates, error) {
+	hc := &HistoryCubes{}
+	err := xml.NewDecoder(r).Decode(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]DailyRates, 0, len(hc.Days))
+	for _, d := range hc.Days {
+		date, err := parseECBDate(d.Time)
+		if err != nil {
+			return nil, err
+		}
+
+		rates := make(map[string]float64, len(d.Rates))
+		for _, c := range d.Rates {
+			v, err := strconv.ParseFloat(c.Rate, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rate of %s on %s: %w", c.Currency, d.Time, err)
+			}
+
+			rates[c.Currency] = v
+		}
+
+		days = append(days, DailyRates{date, rates})
+	}
+
+	return sortDays(days), nil
+}
+
+// ParseECBHistoryCSV parses the ECB CSV files, a Date column followed by a
+// column per currency. Currencies without a rate on a day are marked N/A
+func ParseECBHistoryCSV(r io.Reader) ([]DailyRates, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	// the rows end with a separator, the field counts are checked below
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(header) == 0 || !strings.EqualFold(strings.TrimSpace(header[0]), "Date") {
+		return nil, fmt.Errorf("expected a Date column first, got %q", header)
+	}
+
+	var days []DailyRates
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rec) > len(header) {
+			return nil, fmt.Errorf("line %d has %d fields, the header has %d", line, len(rec), len(header))
+		}
+
+		date, err := parseECBDate(rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		rates := make(map[string]float64, len(rec)-1)
+		for i := 1; i < len(rec); i++ {
+			code, v := strings.TrimSpace(header[i]), strings.TrimSpace(rec[i])
+			if code == "" || v == "" || v == "N/A" {
+				continue
+			}
+
+			r, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid rate of %s: %w", line, code, err)
+			}
+
+			rates[code] = r
+		}
+
+		days = append(days, DailyRates{date, rates})
+	}
+
+	return sortDays(days), nil
+}
+
+// parseECBDate parses the dates of the ECB files, 2023-05-12 in the XML and
+// history CSV and 12 May 2023 in the daily CSV
+func parseECBDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"2006-01-02", "02 January 2006", "2 January 2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+func sortDays(days []DailyRates) []DailyRates {
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+	return days
+}
+
+// SeedHistory records the reference rates of past days in the history,
+// quoted against the pivot. Days without a rate of the pivot are skipped,
+// it returns the number of days recorded. Seeded days are kept regardless
+// of the retention of the history
+func (e *ExchangeRates) SeedHistory(days []DailyRates) int {
+	pivot := e.Pivot()
+
+	n := 0
+	for _, d := range days {
+		rates := make(map[string]float64, len(d.Rates)+1)
+		for k, v := range d.Rates {
+			rates[k] = v
+		}
+		rates["EUR"] = 1
+
+		p, ok := rates[pivot]
+		if !ok {
+			continue
+		}
+
+		for k, v := range rates {
+			rates[k] = v / p
+		}
+
+		e.history.Seed(d.Date.Add(ecbPublication), rates)
+		n++
+	}
+
+	return n
+}
@@ -0,0 +1,168 @@
+package data
+
+import (
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestLoadECBHistory(t *testing.T) {
+	tests := []struct {
+		file  string
+		days  int
+		first time.Time
+		last  DailyRates
+	}{
+		{"eurofxref-hist-90d.xml", 3, date("2023-05-10"), DailyRates{date("2023-05-12"), map[string]float64{"USD": 1.0899, "JPY": 147.15, "GBP": 0.87, "CHF": 0.976}}},
+		{"eurofxref-hist.csv", 4, date("1999-01-04"), DailyRates{date("2023-05-12"), map[string]float64{"USD": 1.0899, "JPY": 147.15, "GBP": 0.87, "CHF": 0.976}}},
+		{"eurofxref-hist.zip", 4, date("1999-01-04"), DailyRates{date("2023-05-12"), map[string]float64{"USD": 1.0899, "JPY": 147.15, "GBP": 0.87, "CHF": 0.976}}},
+		{"eurofxref.csv", 1, date("2023-05-12"), DailyRates{date("2023-05-12"), map[string]float64{"USD": 1.0899, "JPY": 147.15, "GBP": 0.87, "CHF": 0.976}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.file, func(t *testing.T) {
+			days, err := LoadECBHistory(filepath.Join("testdata", tc.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(days) != tc.days || !days[0].Date.Equal(tc.first) {
+				t.Fatalf("expected %d days from %s, got %#v", tc.days, tc.first, days)
+			}
+
+			last := days[len(days)-1]
+			if !last.Date.Equal(tc.last.Date) || len(last.Rates) != len(tc.last.Rates) {
+				t.Fatalf("expected %#v, got %#v", tc.last, last)
+			}
+
+			for k, v := range tc.last.Rates {
+				if last.Rates[k] != v {
+					t.Errorf("expected %s %f, got %f", k, v, last.Rates[k])
+				}
+			}
+		})
+	}
+}
+
+func TestParseECBHistoryCSVMissingRates(t *testing.T) {
+	days, err := LoadECBHistory(filepath.Join("testdata", "eurofxref-hist.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// CYP was replaced by EUR in 2008, later days mark it N/A
+	if _, ok := days[len(days)-1].Rates["CYP"]; ok {
+		t.Fatalf("expected no CYP rate in 2023, got %#v", days[len(days)-1].Rates)
+	}
+
+	if days[1].Rates["CYP"] != 0.585274 {
+		t.Fatalf("expected the CYP rate of 2007, got %#v", days[1].Rates)
+	}
+}
+
+func TestParseECBHistoryInvalid(t *testing.T) {
+	for name, in := range map[string]string{
+		"no date column": "USD,JPY\n1.1,160\n",
+		"invalid date":   "Date,USD\n12/05/2023,1.1\n",
+		"invalid rate":   "Date,USD\n2023-05-12,abc\n",
+		"extra fields":   "Date,USD\n2023-05-12,1.1,2.2,3.3\n",
+	} {
+		if _, err := ParseECBHistoryCSV(strings.NewReader(in)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := LoadECBHistory(filepath.Join("testdata", "missing.txt")); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestSeedHistory(t *testing.T) {
+	days, err := LoadECBHistory(filepath.Join("testdata", "eurofxref-hist-90d.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160, "GBP": 0.85})
+	tr := NewRates(hclog.NewNullLogger(), p, "USD", NewHistory(0), nil, nil)
+
+	if n := tr.SeedHistory(days); n != 3 {
+		t.Fatalf("expected 3 seeded days, got %d", n)
+	}
+
+	// the rates of a day apply from their publication on
+	r, err := tr.GetHistoricalRate("EUR", "JPY", date("2023-05-11").Add(23*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Rate-146.94) > 1e-9 || !r.Time.Equal(date("2023-05-11").Add(ecbPublication)) {
+		t.Fatalf("expected 146.94 JPY per EUR on 2023-05-11, got %#v", r)
+	}
+
+	_, err = tr.GetHistoricalRate("EUR", "JPY", date("2023-05-10"))
+	if err == nil {
+		t.Fatal("expected no rate before the first publication")
+	}
+
+	// the live rates follow the seeded days
+	ps, err := tr.GetRateSeries("USD", "GBP", date("2023-05-10"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ps) != 4 || math.Abs(ps[0].Rate-0.86965/1.0976) > 1e-9 {
+		t.Fatalf("expected 3 seeded and 1 live rate, got %#v", ps)
+	}
+}
+
+func TestSeedHistoryRetention(t *testing.T) {
+	days, err := LoadECBHistory(filepath.Join("testdata", "eurofxref-hist.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the default retention of the service
+	h := NewHistory(24 * time.Hour)
+	p := NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160, "GBP": 0.85})
+	tr := NewRates(hclog.NewNullLogger(), p, "EUR", h, nil, nil)
+
+	if n := tr.SeedHistory(days); n != len(days) {
+		t.Fatalf("expected %d seeded days, got %d", len(days), n)
+	}
+
+	// recording the live rates expires nothing but the live rates
+	err = tr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ps, err := tr.GetRateSeries("EUR", "USD", days[0].Date, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ps) != len(days)+2 {
+		t.Fatalf("expected %d seeded and 2 live rates, got %#v", len(days), ps)
+	}
+
+	h.Record(time.Now().Add(48*time.Hour), map[string]float64{"EUR": 1, "USD": 1.2})
+
+	ps, err = tr.GetRateSeries("EUR", "USD", days[0].Date, time.Now().Add(72*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ps) != len(days)+1 || ps[len(ps)-1].Rate != 1.2 {
+		t.Fatalf("expected the seeded days to outlive the retention, got %#v", ps)
+	}
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"sort"
+	"strings"
+)
+
+// pairKey returns the key of a quote, 1 base buys the quote in dest
+func pairKey(base, dest string) string {
+	return base + "/" + dest
+}
+
+// splitPair returns the currencies of a quote key
+func splitPair(k string) (string, string) {
+	base, dest, _ := strings.Cut(k, "/")
+	return base, dest
+}
+
+// graph holds the currencies linked by a quote in either direction, the
+// neighbours are ordered with the pivot first so that of two paths with
+// the same length the one through the pivot is taken
+type graph map[string][]string
+
+func newGraph(quotes map[string]float64, pivot string) graph {
+	g := graph{}
+	for k := range quotes {
+		b, d := splitPair(k)
+		g[b] = append(g[b], d)
+		g[d] = append(g[d], b)
+	}
+
+	for c, ns := range g {
+		sort.Slice(ns, func(i, j int) bool {
+			if ns[i] == pivot || ns[j] == pivot {
+				return ns[i] == pivot
+			}
+			return ns[i] < ns[j]
+		})
+
+		// a pair quoted in both directions links the currencies once
+		n := ns[:0]
+		for i, v := range ns {
+			if i == 0 || v != ns[i-1] {
+				n = append(n, v)
+			}
+		}
+		g[c] = n
+	}
+
+	return g
+}
+
+// tree returns the predecessor of every currency on its shortest path
+// from start, in the order the currencies were reached
+func (g graph) tree(start string) (map[string]string, []string) {
+	prev := map[string]string{start: ""}
+	order := []string{start}
+
+	for i := 0; i < len(order); i++ {
+		c := order[i]
+		for _, n := range g[c] {
+			if _, ok := prev[n]; ok {
+				continue
+			}
+
+			prev[n] = c
+			order = append(order, n)
+		}
+	}
+
+	return prev, order
+}
+
+// path returns the shortest path from base to dest
+func (g graph) path(base, dest string) ([]string, bool) {
+	prev, _ := g.tree(base)
+	if _, ok := prev[dest]; !ok {
+		return nil, false
+	}
+
+	path := []string{dest}
+	for c := dest; c != base; {
+		c = prev[c]
+		path = append(path, c)
+	}
+
+	// the path was collected from dest back to base
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, true
+}
+
+// leg returns the key of the quote linking two currencies and whether
+// it is quoted in the opposite direction
+func leg(quotes map[string]float64, from, to string) (string, bool) {
+	if k := pairKey(from, to); quotes[k] > 0 {
+		return k, false
+	}
+
+	return pairKey(to, from), true
+}
+
+// legRate returns the rate from one currency to a linked one
+func legRate(quotes map[string]float64, from, to string) float64 {
+	k, inverse := leg(quotes, from, to)
+	if inverse {
+		return 1 / quotes[k]
+	}
+
+	return quotes[k]
+}
+
+// pathRate multiplies the rates of the legs of a path
+func pathRate(quotes map[string]float64, path []string) float64 {
+	r := 1.0
+	for i := 1; i < len(path); i++ {
+		r *= legRate(quotes, path[i-1], path[i])
+	}
+
+	return r
+}
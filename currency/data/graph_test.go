@@ -0,0 +1,91 @@
+package data
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestRatePaths(t *testing.T) {
+	// USD quoted rates with a direct EUR/GBP quote, an ECB like feed would
+	// have every currency quoted against EUR
+	p := NewFixedProvider(map[string]float64{
+		"USD/EUR": 0.9,
+		"USD/JPY": 150,
+		"USD/CHF": 0.88,
+		"EUR/GBP": 0.85,
+		"EUR/CHF": 0.97,
+	})
+
+	tr := NewRates(hclog.NewNullLogger(), p, "EUR", NewHistory(0), nil, nil)
+
+	tests := []struct {
+		name       string
+		base, dest string
+		path       []string
+		rate       float64
+	}{
+		{"direct", "USD", "JPY", []string{"USD", "JPY"}, 150},
+		{"inverse", "JPY", "USD", []string{"JPY", "USD"}, 1.0 / 150},
+		{"direct over the pivot", "USD", "CHF", []string{"USD", "CHF"}, 0.88},
+		{"through the pivot", "JPY", "GBP", []string{"JPY", "USD", "EUR", "GBP"}, 1.0 / 150 * 0.9 * 0.85},
+		{"pivot preferred over other paths", "GBP", "CHF", []string{"GBP", "EUR", "CHF"}, 1 / 0.85 * 0.97},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := tr.GetRate(tc.base, tc.dest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(r.Path, tc.path) {
+				t.Fatalf("expected path %v, got %v", tc.path, r.Path)
+			}
+
+			if math.Abs(r.Value-tc.rate) > 1e-9 {
+				t.Fatalf("expected rate %f, got %f", tc.rate, r.Value)
+			}
+		})
+	}
+
+	// the history is quoted against the pivot
+	if math.Abs(tr.rates.Load().rates["USD"]-1/0.9) > 1e-9 {
+		t.Fatalf("expected USD quoted against EUR, got %f", tr.rates.Load().rates["USD"])
+	}
+}
+
+func TestRatePathsDisconnected(t *testing.T) {
+	p := NewFixedProvider(map[string]float64{"USD": 1.1, "GBP/CHF": 1.13})
+
+	tr := NewRates(hclog.NewNullLogger(), p, "EUR", NewHistory(0), nil, nil)
+
+	if _, err := tr.GetRate("USD", "CHF"); err == nil {
+		t.Fatal("expected an error for currencies without linking quotes")
+	}
+
+	r, err := tr.GetRate("CHF", "GBP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-1/1.13) > 1e-9 {
+		t.Fatalf("expected the inverse of the direct quote, got %f", r.Value)
+	}
+}
+
+func TestRebase(t *testing.T) {
+	p := Rebase(NewFixedProvider(map[string]float64{"JPY": 150, "EUR/GBP": 0.85}), "USD")
+
+	rates, err := p.Rates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]float64{"USD/JPY": 150, "EUR/GBP": 0.85}
+	if !reflect.DeepEqual(rates, want) {
+		t.Fatalf("expected %v, got %v", want, rates)
+	}
+}
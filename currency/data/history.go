@@ -0,0 +1,174 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNoRateHistory is returned when no rates were recorded for the requested time
+var ErrNoRateHistory = fmt.Errorf("no rates recorded for the requested time")
+
+// RateSnapshot is the full set of rates quoted against the pivot at a point in time
+type RateSnapshot struct {
+	Time  time.Time
+	Rates map[string]float64
+	// seeded snapshots are past rates loaded on startup, they are kept
+	// regardless of the retention
+	seeded bool
+}
+
+// RatePoint is a single cross rate at a point in time
+type RatePoint struct {
+	Time time.Time
+	Rate float64
+}
+
+// History is a time-indexed store of rate snapshots, snapshots older
+// than the retention period are discarded. Only the rates against the pivot
+// are recorded, the rate of a pair is the mid rate through the pivot even
+// where the rate store serves a direct quote or an override of the pair
+type History struct {
+	mu        sync.RWMutex
+	retention time.Duration
+	snapshots []RateSnapshot
+}
+
+// NewHistory creates a history store, a zero retention keeps every snapshot
+func NewHistory(retention time.Duration) *History {
+	return &History{retention: retention}
+}
+
+// Record stores a copy of the rates as they were at time t
+func (h *History) Record(t time.Time, rates map[string]float64) {
+	h.record(t, rates, false)
+}
+
+// Seed stores a copy of past rates, they are kept regardless of the retention
+func (h *History) Seed(t time.Time, rates map[string]float64) {
+	h.record(t, rates, true)
+}
+
+func (h *History) record(t time.Time, rates map[string]float64, seeded bool) {
+	s := RateSnapshot{Time: t, Rates: make(map[string]float64, len(rates)), seeded: seeded}
+	for k, v := range rates {
+		s.Rates[k] = v
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// snapshots usually arrive in order, seeded history may not
+	i := sort.Search(len(h.snapshots), func(i int) bool { return h.snapshots[i].Time.After(t) })
+	h.snapshots = append(h.snapshots, RateSnapshot{})
+	copy(h.snapshots[i+1:], h.snapshots[i:])
+	h.snapshots[i] = s
+
+	h.expire(t)
+}
+
+// expire drops the snapshots that fall out of the retention period, except
+// the seeded ones
+func (h *History) expire(now time.Time) {
+	if h.retention <= 0 || len(h.snapshots) == 0 {
+		return
+	}
+
+	last := h.snapshots[len(h.snapshots)-1].Time
+	if now.Before(last) {
+		now = last
+	}
+
+	cut := now.Add(-h.retention)
+	i := sort.Search(len(h.snapshots), func(i int) bool { return !h.snapshots[i].Time.Before(cut) })
+	if i == 0 {
+		return
+	}
+
+	kept := []RateSnapshot{}
+	for _, s := range h.snapshots[:i] {
+		if s.seeded {
+			kept = append(kept, s)
+		}
+	}
+
+	// nothing but seeded snapshots falls out of the retention
+	if len(kept) == i {
+		return
+	}
+
+	h.snapshots = append(kept, h.snapshots[i:]...)
+}
+
+// At returns the base to destination rate that applied at time t
+// together with the time it was recorded. Snapshots that lack either
+// currency are skipped, the rate comes from the latest one that has both
+func (h *History) At(base, dest string, t time.Time) (RatePoint, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	i := sort.Search(len(h.snapshots), func(i int) bool { return h.snapshots[i].Time.After(t) })
+	if i == 0 {
+		return RatePoint{}, ErrNoRateHistory
+	}
+
+	var err error
+	for ; i > 0; i-- {
+		s := h.snapshots[i-1]
+
+		var r float64
+		r, err = crossRate(s.Rates, base, dest)
+		if err == nil {
+			return RatePoint{Time: s.Time, Rate: r}, nil
+		}
+	}
+
+	return RatePoint{}, err
+}
+
+// Series returns every recorded base to destination rate between start and
+// end inclusive. Snapshots that lack either currency are skipped, the rate
+// is not found only when none of the snapshots in the range has both
+func (h *History) Series(base, dest string, start, end time.Time) ([]RatePoint, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	i := sort.Search(len(h.snapshots), func(i int) bool { return !h.snapshots[i].Time.Before(start) })
+
+	var err error
+	points := []RatePoint{}
+	for ; i < len(h.snapshots) && !h.snapshots[i].Time.After(end); i++ {
+		s := h.snapshots[i]
+
+		var r float64
+		r, err = crossRate(s.Rates, base, dest)
+		if err != nil {
+			continue
+		}
+
+		points = append(points, RatePoint{Time: s.Time, Rate: r})
+	}
+
+	if len(points) == 0 && err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// crossRate calculates the base to destination rate from the rates of a
+// snapshot, they are quoted against the same pivot
+func crossRate(rates map[string]float64, base, dest string) (float64, error) {
+	br, ok := rates[base]
+	if !ok {
+		return 0, fmt.Errorf("%w for currency %s", ErrRateNotFound, base)
+	}
+
+	dr, ok := rates[dest]
+	if !ok {
+		return 0, fmt.Errorf("%w for currency %s", ErrRateNotFound, dest)
+	}
+
+	return dr / br, nil
+}
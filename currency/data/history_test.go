@@ -0,0 +1,139 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHistoryAt(t *testing.T) {
+	h := NewHistory(0)
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	h.Record(t0, map[string]float64{"EUR": 1, "USD": 1.1})
+	h.Record(t0.Add(2*time.Minute), map[string]float64{"EUR": 1, "USD": 1.3})
+	// out of order snapshot must be placed between the two others
+	h.Record(t0.Add(time.Minute), map[string]float64{"EUR": 1, "USD": 1.2})
+
+	_, err := h.At("EUR", "USD", t0.Add(-time.Second))
+	if err != ErrNoRateHistory {
+		t.Fatalf("expected ErrNoRateHistory, got %v", err)
+	}
+
+	rp, err := h.At("EUR", "USD", t0.Add(90*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rp.Rate != 1.2 || !rp.Time.Equal(t0.Add(time.Minute)) {
+		t.Fatalf("unexpected rate %#v", rp)
+	}
+}
+
+func TestHistorySeries(t *testing.T) {
+	h := NewHistory(0)
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		h.Record(t0.Add(time.Duration(i)*time.Minute), map[string]float64{"EUR": 1, "USD": float64(i + 1)})
+	}
+
+	points, err := h.Series("USD", "EUR", t0.Add(time.Minute), t0.Add(3*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(points) != 3 {
+		t.Fatalf("expected 3 points, got %d", len(points))
+	}
+
+	if points[0].Rate != 0.5 || points[2].Rate != 0.25 {
+		t.Fatalf("unexpected points %#v", points)
+	}
+}
+
+func TestHistoryRetention(t *testing.T) {
+	h := NewHistory(time.Hour)
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	h.Record(t0, map[string]float64{"EUR": 1, "USD": 1.1})
+	h.Record(t0.Add(2*time.Hour), map[string]float64{"EUR": 1, "USD": 1.2})
+
+	_, err := h.At("EUR", "USD", t0.Add(time.Hour))
+	if err != ErrNoRateHistory {
+		t.Fatalf("expected the first snapshot to be expired, got %v", err)
+	}
+}
+
+func TestHistoryMissingCurrency(t *testing.T) {
+	h := NewHistory(0)
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	h.Record(t0, map[string]float64{"EUR": 1, "USD": 1.1, "BTC": 0.00002})
+	// BTC is not quoted for a while
+	h.Record(t0.Add(time.Minute), map[string]float64{"EUR": 1, "USD": 1.2})
+	h.Record(t0.Add(2*time.Minute), map[string]float64{"EUR": 1, "USD": 1.3, "BTC": 0.00004})
+
+	points, err := h.Series("EUR", "BTC", t0, t0.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(points) != 2 || !points[1].Time.Equal(t0.Add(2*time.Minute)) {
+		t.Fatalf("expected the snapshot without BTC to be skipped, got %#v", points)
+	}
+
+	rp, err := h.At("EUR", "BTC", t0.Add(90*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !rp.Time.Equal(t0) {
+		t.Fatalf("expected the rate of the last snapshot with BTC, got %#v", rp)
+	}
+
+	_, err = h.Series("EUR", "BTC", t0.Add(time.Minute), t0.Add(90*time.Second))
+	if !errors.Is(err, ErrRateNotFound) {
+		t.Fatalf("expected ErrRateNotFound without any BTC rate, got %v", err)
+	}
+
+	_, err = h.At("EUR", "XAU", t0.Add(time.Hour))
+	if !errors.Is(err, ErrRateNotFound) {
+		t.Fatalf("expected ErrRateNotFound for an unknown currency, got %v", err)
+	}
+}
+
+// TestHistoryThroughPivot pins the documented divergence of the history
+// from the served rates, the history holds mid rates through the pivot
+func TestHistoryThroughPivot(t *testing.T) {
+	tr := newTestRates(t)
+
+	_, err := tr.SetOverride("USD", "JPY", 150, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tr.SetSpreads(SpreadRules{Default: 0.01})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := tr.GetRate("USD", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Value != 150 || len(r.Path) != 2 || r.Bid >= r.Value {
+		t.Fatalf("expected the direct override with a spread, got %#v", r)
+	}
+
+	p, err := tr.GetHistoricalRate("USD", "JPY", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := 160 / 1.1; math.Abs(p.Rate-want) > 1e-9 {
+		t.Fatalf("expected the mid rate %f through EUR in the history, got %f", want, p.Rate)
+	}
+}
@@ -0,0 +1,150 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// OverrideSource is the source of the rates set by hand
+const OverrideSource = "override"
+
+// ErrNoOverride is returned when a pair has no override to clear
+var ErrNoOverride = errors.New("no override for the pair")
+
+// Override is a quote set by hand, it replaces the quote of the pair from
+// the provider or the simulator until it is cleared or expires
+type Override struct {
+	Base string `json:"base"`
+	Dest string `json:"dest"`
+	// Rate is the amount of Dest 1 Base buys
+	Rate float64 `json:"rate"`
+	// Set is the time the override was set
+	Set time.Time `json:"set"`
+	// Expires is the time the override is removed, zero if it does not expire
+	Expires time.Time `json:"expires"`
+}
+
+// SetOverride pins the rate of a pair, every rate whose path uses the pair
+// follows the override. A positive ttl removes the override once it elapses
+func (e *ExchangeRates) SetOverride(base, dest string, rate float64, ttl time.Duration) (Override, error) {
+	if base == dest {
+		return Override{}, fmt.Errorf("base currency %s cannot be the same as the destination currency", base)
+	}
+
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		return Override{}, fmt.Errorf("rate of %s must be positive and finite, got %f", pairKey(base, dest), rate)
+	}
+
+	if ttl < 0 {
+		return Override{}, fmt.Errorf("ttl must not be negative, got %s", ttl)
+	}
+
+	rs := e.rates.Load()
+	for _, c := range []string{base, dest} {
+		if _, ok := rs.graph[c]; !ok && c != rs.pivot {
+			return Override{}, fmt.Errorf("%w for currency %s", ErrRateNotFound, c)
+		}
+	}
+
+	var o Override
+	e.update(CauseOverride, func(rs *rateSet, now time.Time) {
+		o = Override{Base: base, Dest: dest, Rate: rate, Set: now}
+		if ttl > 0 {
+			o.Expires = now.Add(ttl)
+		}
+
+		// a pair has a single override whichever the direction
+		delete(rs.overrides, pairKey(dest, base))
+		rs.overrides[pairKey(base, dest)] = o
+	})
+
+	if ttl > 0 {
+		time.AfterFunc(ttl, e.expireOverrides)
+	}
+
+	e.log.Info("Rate overridden", "base", base, "dest", dest, "rate", rate, "ttl", ttl)
+
+	return o, nil
+}
+
+// ClearOverride removes the override of a pair in either direction and
+// returns it, the pair goes back to the quote of the provider or the simulator.
+// The rates are left untouched when the pair has no override
+func (e *ExchangeRates) ClearOverride(base, dest string) (Override, error) {
+	var o Override
+	cleared := e.updateIf(CauseOverrideCleared, func(rs *rateSet, now time.Time) bool {
+		for _, k := range []string{pairKey(base, dest), pairKey(dest, base)} {
+			var ok bool
+			if o, ok = rs.overrides[k]; ok {
+				delete(rs.overrides, k)
+				return true
+			}
+		}
+
+		return false
+	})
+
+	if !cleared {
+		return Override{}, ErrNoOverride
+	}
+
+	e.log.Info("Rate override cleared", "base", o.Base, "dest", o.Dest)
+
+	return o, nil
+}
+
+// Overrides returns the overrides in effect sorted by pair
+func (e *ExchangeRates) Overrides() []Override {
+	rs := e.rates.Load()
+
+	res := make([]Override, 0, len(rs.overrides))
+	for _, o := range rs.overrides {
+		res = append(res, o)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return pairKey(res[i].Base, res[i].Dest) < pairKey(res[j].Base, res[j].Dest)
+	})
+
+	return res
+}
+
+// expireOverrides removes the overrides whose ttl has elapsed, an override
+// set again since the timer started is kept until its own expiry
+func (e *ExchangeRates) expireOverrides() {
+	now := time.Now()
+
+	expired := false
+	for _, o := range e.rates.Load().overrides {
+		if !o.Expires.IsZero() && !o.Expires.After(now) {
+			expired = true
+		}
+	}
+
+	if !expired {
+		return
+	}
+
+	e.update(CauseOverrideExpired, func(rs *rateSet, _ time.Time) {
+		for k, o := range rs.overrides {
+			if !o.Expires.IsZero() && !o.Expires.After(now) {
+				delete(rs.overrides, k)
+				e.log.Info("Rate override expired", "base", o.Base, "dest", o.Dest)
+			}
+		}
+	})
+}
+
+// scheduleOverrides removes the overrides that expired while the rate store
+// was not running and starts the expiry timers of the others
+func (e *ExchangeRates) scheduleOverrides() {
+	e.expireOverrides()
+	for _, o := range e.Overrides() {
+		if !o.Expires.IsZero() {
+			time.AfterFunc(time.Until(o.Expires), e.expireOverrides)
+		}
+	}
+}
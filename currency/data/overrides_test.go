@@ -0,0 +1,155 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSetOverride(t *testing.T) {
+	tr := newTestRates(t)
+
+	o, err := tr.SetOverride("USD", "EUR", 0.5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !o.Expires.IsZero() {
+		t.Fatalf("expected an override without expiry, got %#v", o)
+	}
+
+	// the override replaces the provider quote in both directions
+	r, err := tr.GetRate("EUR", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Value != 2 || r.Source != OverrideSource || !r.AsOf.Equal(o.Set) {
+		t.Fatalf("expected 2 USD per EUR from the override, got %#v", r)
+	}
+
+	// cross rates through the pair follow the override
+	r, err = tr.GetRate("USD", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-80) > 1e-9 || r.Source != OverrideSource+"+fixed" {
+		t.Fatalf("expected 80 JPY per USD from the override and the provider, got %#v", r)
+	}
+
+	if os := tr.Overrides(); len(os) != 1 || os[0].Base != "USD" || os[0].Dest != "EUR" {
+		t.Fatalf("expected the USD/EUR override, got %#v", os)
+	}
+
+	// the pair is cleared in either direction
+	_, err = tr.ClearOverride("EUR", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, _ = tr.GetRate("EUR", "USD")
+	if r.Value != 1.1 || r.Source != "fixed" {
+		t.Fatalf("expected the provider rate back, got %#v", r)
+	}
+
+	_, err = tr.ClearOverride("EUR", "USD")
+	if !errors.Is(err, ErrNoOverride) {
+		t.Fatalf("expected ErrNoOverride, got %v", err)
+	}
+
+	// clearing a missing override leaves the rates as they are
+	if cr, _ := tr.GetRate("EUR", "USD"); cr.Sequence != r.Sequence {
+		t.Fatalf("expected sequence %d, got %d", r.Sequence, cr.Sequence)
+	}
+}
+
+func TestOverrideInvalid(t *testing.T) {
+	tr := newTestRates(t)
+
+	for _, tc := range []struct {
+		base, dest string
+		rate       float64
+		ttl        time.Duration
+	}{
+		{"EUR", "EUR", 1, 0},
+		{"EUR", "USD", 0, 0},
+		{"EUR", "USD", math.NaN(), 0},
+		{"EUR", "USD", math.Inf(1), 0},
+		{"EUR", "USD", 1, -time.Second},
+		{"EUR", "KES", 1, 0},
+	} {
+		_, err := tr.SetOverride(tc.base, tc.dest, tc.rate, tc.ttl)
+		if err == nil {
+			t.Errorf("expected an error for %#v", tc)
+		}
+	}
+}
+
+func TestOverrideExpires(t *testing.T) {
+	tr := newTestRates(t)
+
+	_, err := tr.SetOverride("EUR", "USD", 2, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for len(tr.Overrides()) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the override to expire")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	r, _ := tr.GetRate("EUR", "USD")
+	if r.Value != 1.1 {
+		t.Fatalf("expected the provider rate after the override expired, got %#v", r)
+	}
+}
+
+func TestOverrideOutlivesSimulator(t *testing.T) {
+	tr := newTestRates(t)
+
+	_, err := tr.SetOverride("EUR", "USD", 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ru := tr.MonitorRates(time.Millisecond)
+	<-ru
+	<-ru
+
+	r, _ := tr.GetRate("EUR", "USD")
+	if r.Value != 2 || r.Source != OverrideSource {
+		t.Fatalf("expected the override to take priority over the simulator, got %#v", r)
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	tr := newTestRates(t)
+	tr.Freeze(true)
+
+	before, _ := tr.GetRate("EUR", "JPY")
+
+	ru := tr.MonitorRates(time.Millisecond)
+	<-ru
+	<-ru
+
+	after, _ := tr.GetRate("EUR", "JPY")
+	if after.Value != before.Value || after.Sequence != before.Sequence {
+		t.Fatalf("expected frozen rates, got %#v after %#v", after, before)
+	}
+
+	// a refresh still applies while frozen
+	err := tr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, _ = tr.GetRate("EUR", "JPY")
+	if after.Sequence <= before.Sequence || after.Source != "fixed" {
+		t.Fatalf("expected refreshed rates, got %#v", after)
+	}
+}
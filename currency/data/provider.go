@@ -0,0 +1,248 @@
+package data
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ECBDailyURL is the location of the ECB daily reference rates
+const ECBDailyURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+// RateProvider is a source of exchange rates. Rates are keyed by currency
+// code quoted against EUR or by pair in the form BASE/DEST for direct quotes
+type RateProvider interface {
+	// Name identifies the provider in logs and responses
+	Name() string
+	// Rates returns the current rate for every known currency or pair
+	Rates() (map[string]float64, error)
+}
+
+// rebasedProvider quotes the currency codes of a provider against another currency
+type rebasedProvider struct {
+	RateProvider
+	base string
+}
+
+// Rebase returns a provider whose currency codes are quoted against base
+// instead of EUR, pairs are kept as they are
+func Rebase(p RateProvider, base string) RateProvider {
+	if base == "" || base == "EUR" {
+		return p
+	}
+
+	return &rebasedProvider{p, base}
+}
+
+func (p *rebasedProvider) Rates() (map[string]float64, error) {
+	rates, err := p.RateProvider.Rates()
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]float64, len(rates))
+	for k, v := range rates {
+		if !strings.Contains(k, "/") {
+			k = p.base + "/" + k
+		}
+		r[k] = v
+	}
+
+	return r, nil
+}
+
+// ECBProvider fetches the daily reference rates from the European Central Bank
+type ECBProvider struct {
+	url    string
+	client *http.Client
+}
+
+func NewECBProvider(url string, client *http.Client) *ECBProvider {
+	if url == "" {
+		url = ECBDailyURL
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &ECBProvider{url, client}
+}
+
+func (p *ECBProvider) Name() string {
+	return "ecb"
+}
+
+func (p *ECBProvider) Rates() (map[string]float64, error) {
+	resp, err := p.client.Get(p.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exprected status code 200, got %d", resp.StatusCode)
+	}
+
+	return parseECB(resp.Body)
+}
+
+// FileProvider reads rates from a local file, the format is chosen by
+// the file extension: .xml (ECB daily document), .json or .csv
+type FileProvider struct {
+	path string
+}
+
+func NewFileProvider(path string) *FileProvider {
+	return &FileProvider{path}
+}
+
+func (p *FileProvider) Name() string {
+	return "file"
+}
+
+func (p *FileProvider) Rates() (map[string]float64, error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(p.path)) {
+	case ".xml":
+		return parseECB(f)
+	case ".json":
+		return parseJSON(f)
+	case ".csv":
+		return parseCSV(f)
+	}
+
+	return nil, fmt.Errorf("unsupported rates file format: %s", p.path)
+}
+
+// FixedProvider serves a fixed in-memory table of rates
+type FixedProvider struct {
+	rates map[string]float64
+}
+
+func NewFixedProvider(rates map[string]float64) *FixedProvider {
+	r := make(map[string]float64, len(rates))
+	for k, v := range rates {
+		r[k] = v
+	}
+
+	return &FixedProvider{r}
+}
+
+func (p *FixedProvider) Name() string {
+	return "fixed"
+}
+
+func (p *FixedProvider) Rates() (map[string]float64, error) {
+	r := make(map[string]float64, len(p.rates))
+	for k, v := range p.rates {
+		r[k] = v
+	}
+
+	return r, nil
+}
+
+// ParseCurrencyValues parses a list of values per currency in the form "USD=1.09,JPY=157.2"
+func ParseCurrencyValues(s string) (map[string]float64, error) {
+	rates := map[string]float64{}
+
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+
+		c, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid value %q, expected CODE=value", kv)
+		}
+
+		r, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		rates[strings.ToUpper(strings.TrimSpace(c))] = r
+	}
+
+	return rates, nil
+}
+
+func parseECB(r io.Reader) (map[string]float64, error) {
+	md := &Cubes{}
+	err := xml.NewDecoder(r).Decode(&md)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := map[string]float64{}
+	for _, c := range md.CubeData {
+		r, err := strconv.ParseFloat(c.Rate, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		rates[c.Currency] = r
+	}
+
+	return rates, nil
+}
+
+// parseJSON reads an object mapping currency codes to rates
+func parseJSON(r io.Reader) (map[string]float64, error) {
+	rates := map[string]float64{}
+	err := json.NewDecoder(r).Decode(&rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// parseCSV reads "currency,rate" records, an optional header is skipped
+func parseCSV(r io.Reader) (map[string]float64, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	cr.TrimLeadingSpace = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rates := map[string]float64{}
+	for i, rec := range records {
+		v, err := strconv.ParseFloat(rec[1], 64)
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, err
+		}
+
+		rates[strings.ToUpper(rec[0])] = v
+	}
+
+	return rates, nil
+}
+
+type Cubes struct {
+	CubeData []Cube `xml:"Cube>Cube>Cube"`
+}
+
+type Cube struct {
+	Currency string `xml:"currency,attr"`
+	Rate     string `xml:"rate,attr"`
+}
@@ -0,0 +1,80 @@
+package data
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const ecbDaily = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2023-05-12">
+			<Cube currency="USD" rate="1.0899"/>
+			<Cube currency="JPY" rate="147.15"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestECBProvider(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(ecbDaily))
+	}))
+	defer ts.Close()
+
+	rates, err := NewECBProvider(ts.URL, ts.Client()).Rates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rates["USD"] != 1.0899 || rates["JPY"] != 147.15 {
+		t.Fatalf("unexpected rates %#v", rates)
+	}
+}
+
+func TestFileProvider(t *testing.T) {
+	files := map[string]string{
+		"rates.xml":  ecbDaily,
+		"rates.json": `{"USD": 1.0899, "JPY": 147.15}`,
+		"rates.csv":  "currency,rate\nUSD,1.0899\njpy,147.15\n",
+	}
+
+	dir := t.TempDir()
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			err := os.WriteFile(path, []byte(content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rates, err := NewFileProvider(path).Rates()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if rates["USD"] != 1.0899 || rates["JPY"] != 147.15 {
+				t.Fatalf("unexpected rates %#v", rates)
+			}
+		})
+	}
+}
+
+func TestParseCurrencyValues(t *testing.T) {
+	rates, err := ParseCurrencyValues("USD=1.09, gbp=0.86")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rates["USD"] != 1.09 || rates["GBP"] != 0.86 {
+		t.Fatalf("unexpected rates %#v", rates)
+	}
+
+	_, err = ParseCurrencyValues("USD")
+	if err == nil {
+		t.Fatal("expected an error for a rate without a value")
+	}
+}
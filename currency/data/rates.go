@@ -1,76 +1,531 @@
 package data
 
 import (
-	"encoding/xml"
 	"fmt"
-	"math/rand"
-	"net/http"
-	"strconv"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
 )
 
+// ErrRateNotFound is returned when the store has no rate for a currency pair
+var ErrRateNotFound = fmt.Errorf("rate not found")
+
+// ExchangeRates is the rate store, it is safe for concurrent use
 type ExchangeRates struct {
-	log   hclog.Logger
-	rates map[string]float64
+	log       hclog.Logger
+	provider  RateProvider
+	history   *History
+	simulator *Simulator
+	// audit records every change of the quotes, nil keeps no record
+	audit *AuditLog
+
+	// mu serialises writers, readers load the current set without locking
+	mu      sync.Mutex
+	rates   atomic.Pointer[rateSet]
+	spreads atomic.Pointer[SpreadRules]
+	// frozen stops the simulator and the periodic refresh from changing the rates
+	frozen atomic.Bool
+	// refreshed holds the outcome of the refreshes from the provider
+	refreshed atomic.Pointer[RefreshStatus]
+	// units holds the unit sources, they are reloaded on every refresh
+	units atomic.Pointer[[]unitLoad]
+	// done is closed by Close to stop the goroutines of the rate store
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// RefreshStatus is the outcome of the refreshes of the rates from the provider
+type RefreshStatus struct {
+	// Provider served the last successful refresh at Time
+	Provider string
+	Time     time.Time
+	// Attempted is the time of the last refresh, Err its error if it failed
+	Attempted time.Time
+	Err       error
+	// Restored is the time of the rates restored from a snapshot or
+	// replayed from the audit log
+	Restored time.Time
+}
+
+// Loaded returns the time of the rates served, the last successful refresh
+// or the restored rates when they are more recent
+func (st RefreshStatus) Loaded() time.Time {
+	if st.Restored.After(st.Time) {
+		return st.Restored
+	}
+
+	return st.Time
+}
+
+// SimulatorSource is the source of the rates changed by the simulator
+const SimulatorSource = "simulator"
+
+// rateSet is an immutable set of rates, every change replaces the whole set
+type rateSet struct {
+	pivot string
+	// quotes holds the direct quotes by pair in the form BASE/DEST
+	quotes map[string]float64
+	// updated and sources are kept by pair, sources names what produced
+	// each quote, a provider or the simulator
+	updated map[string]time.Time
+	sources map[string]string
+	// provided holds the quotes as last loaded from the provider
+	provided map[string]float64
+	// units holds the units quoted against a pivot by a unit source
+	units map[string]unit
+	// overrides holds the quotes set by hand by pair, they take priority
+	// over the quotes of the same pair in either direction
+	overrides map[string]Override
+	// sequence is incremented on every change
+	sequence uint64
+
+	// edges, graph, rates and changed are derived from the quotes when the
+	// set is published. edges holds the quotes with the overrides applied,
+	// rates every currency linked to the pivot quoted against it and
+	// changed the last time a quote of each currency changed
+	edges   map[string]float64
+	graph   graph
+	rates   map[string]float64
+	changed map[string]time.Time
+}
+
+func newRateSet(pivot string) *rateSet {
+	rs := &rateSet{
+		pivot:     pivot,
+		quotes:    map[string]float64{},
+		updated:   map[string]time.Time{},
+		sources:   map[string]string{},
+		provided:  map[string]float64{},
+		units:     map[string]unit{},
+		overrides: map[string]Override{},
+	}
+	rs.derive()
+
+	return rs
+}
+
+func (rs *rateSet) clone() *rateSet {
+	c := &rateSet{
+		pivot:     rs.pivot,
+		quotes:    make(map[string]float64, len(rs.quotes)),
+		updated:   make(map[string]time.Time, len(rs.updated)),
+		sources:   make(map[string]string, len(rs.sources)),
+		provided:  rs.provided,
+		units:     make(map[string]unit, len(rs.units)),
+		overrides: make(map[string]Override, len(rs.overrides)),
+		sequence:  rs.sequence,
+	}
+
+	for k, v := range rs.quotes {
+		c.quotes[k] = v
+	}
+
+	for k, v := range rs.updated {
+		c.updated[k] = v
+	}
+
+	for k, v := range rs.sources {
+		c.sources[k] = v
+	}
+
+	for k, v := range rs.units {
+		c.units[k] = v
+	}
+
+	for k, v := range rs.overrides {
+		c.overrides[k] = v
+	}
+
+	return c
+}
+
+// derive computes the graph and the pivot quoted rates from the quotes
+func (rs *rateSet) derive() {
+	rs.edges = make(map[string]float64, len(rs.quotes)+len(rs.overrides))
+	for k, v := range rs.quotes {
+		rs.edges[k] = v
+	}
+
+	for k, o := range rs.overrides {
+		delete(rs.edges, pairKey(o.Dest, o.Base))
+		rs.edges[k] = o.Rate
+	}
+
+	rs.graph = newGraph(rs.edges, rs.pivot)
+
+	rs.rates = map[string]float64{rs.pivot: 1}
+	prev, order := rs.graph.tree(rs.pivot)
+	for _, c := range order[1:] {
+		rs.rates[c] = rs.rates[prev[c]] * legRate(rs.edges, prev[c], c)
+	}
+
+	rs.changed = make(map[string]time.Time, len(rs.graph))
+	for k := range rs.edges {
+		t, _ := rs.provenance(k)
+		b, d := splitPair(k)
+		for _, c := range []string{b, d} {
+			if t.After(rs.changed[c]) {
+				rs.changed[c] = t
+			}
+		}
+	}
+}
+
+// provenance returns the time a quote last changed and what produced it
+func (rs *rateSet) provenance(k string) (time.Time, string) {
+	if o, ok := rs.overrides[k]; ok {
+		return o.Set, OverrideSource
+	}
+
+	return rs.updated[k], rs.sources[k]
+}
+
+// rate returns the base to destination rate with its metadata, it follows
+// the shortest path of quotes between the currencies
+func (rs *rateSet) rate(base, dest string) (Rate, error) {
+	for _, c := range []string{base, dest} {
+		if _, ok := rs.graph[c]; !ok && c != rs.pivot {
+			return Rate{}, fmt.Errorf("%w for currency %s", ErrRateNotFound, c)
+		}
+	}
+
+	path, ok := rs.graph.path(base, dest)
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: no quotes link %s to %s", ErrRateNotFound, base, dest)
+	}
+
+	r := Rate{Value: pathRate(rs.edges, path), Path: path, Sequence: rs.sequence}
+
+	// a cross rate is as old as its oldest leg, distinct sources of the
+	// legs are joined with a +
+	var srcs []string
+	for i := 1; i < len(path); i++ {
+		k, _ := leg(rs.edges, path[i-1], path[i])
+		t, src := rs.provenance(k)
+
+		if r.AsOf.IsZero() || t.Before(r.AsOf) {
+			r.AsOf = t
+		}
+
+		if len(srcs) == 0 || srcs[len(srcs)-1] != src {
+			srcs = append(srcs, src)
+		}
+	}
+	r.Source = strings.Join(srcs, "+")
+
+	return r, nil
+}
+
+// unitQuote reports whether the quote is the quote of a unit against its pivot
+func (rs *rateSet) unitQuote(k string) bool {
+	b, d := splitPair(k)
+	u, ok := rs.units[d]
+
+	return ok && u.Pivot == b
+}
+
+// Rate is a base to destination rate with its provenance
+type Rate struct {
+	// Value is the mid-market rate
+	Value float64
+	// Bid and Ask are the rates with the spread applied
+	Bid float64
+	Ask float64
+	// Path lists the currencies from base to destination whose quotes make up the rate
+	Path []string
+	// AsOf is the time the oldest quote of the path last changed
+	AsOf time.Time
+	// Source is the provider, the simulator or the override that produced the rate
+	Source string
+	// Sequence identifies the version of the rate store the rate was read from
+	Sequence uint64
+}
+
+// Age returns how long ago the rate last changed
+func (r Rate) Age(now time.Time) time.Duration {
+	return now.Sub(r.AsOf)
+}
+
+// RateResult is the outcome for a single destination of a batch lookup
+type RateResult struct {
+	Destination string
+	Rate        Rate
+	Err         error
+}
+
+// CurrencyStatus is a currency the rate store holds a rate for
+type CurrencyStatus struct {
+	CurrencyInfo
+	// Updated is the last time the rate of the currency changed
+	Updated time.Time
 }
 
-func NewRates(l hclog.Logger) (*ExchangeRates, error) {
-	er := &ExchangeRates{log: l, rates: map[string]float64{}}
+// NewRates creates the rate store and loads the initial rates from the provider,
+// every refresh and fluctuation of the rates is recorded in the history quoted
+// against the pivot and in the audit log by pair. Of two equally short paths
+// between currencies the one through the pivot is taken. A nil simulator keeps
+// the rates at the values of the provider, a nil audit log keeps no record.
+// When the initial load fails the store starts empty until a refresh succeeds
+func NewRates(l hclog.Logger, p RateProvider, pivot string, h *History, sim *Simulator, a *AuditLog) *ExchangeRates {
+	er := newExchangeRates(l, p, pivot, h, sim, a)
 
 	err := er.getRates()
 	if err != nil {
-		return nil, err
+		l.Error("Unable to load rates, starting without rates until a refresh succeeds", "error", err)
+	}
+
+	return er
+}
+
+// ReplayRates creates the rate store from the entries of an audit log
+// instead of the provider, the history is rebuilt from the entries. The
+// entries are applied to the snapshot s, those it already holds are
+// skipped, and a nil s replays every entry. Overrides that expired since
+// they were recorded are removed
+func ReplayRates(l hclog.Logger, p RateProvider, pivot string, h *History, sim *Simulator, a *AuditLog, s *Snapshot, entries []AuditEntry) (*ExchangeRates, error) {
+	rs := newRateSet(pivot)
+	var restored time.Time
+	if s != nil {
+		if s.Pivot != pivot {
+			return nil, fmt.Errorf("snapshot is quoted against %s, the pivot is %s", s.Pivot, pivot)
+		}
+
+		rs = s.rateSet()
+		h.Record(s.Time, rs.rates)
+		restored = s.Time
+
+		newer := []AuditEntry{}
+		for _, e := range entries {
+			if e.Sequence > s.Sequence {
+				newer = append(newer, e)
+			}
+		}
+		entries = newer
 	}
 
+	if s == nil && len(entries) == 0 {
+		return nil, fmt.Errorf("no audit entries to replay")
+	}
+
+	er := newExchangeRates(l, p, pivot, h, sim, a)
+
+	rs.replay(entries, h)
+	if len(entries) > 0 {
+		restored = entries[len(entries)-1].Time
+	}
+	er.rates.Store(rs)
+	er.refreshed.Store(&RefreshStatus{Restored: restored})
+
+	er.scheduleOverrides()
+
+	l.Info("Replayed rates", "entries", len(entries), "sequence", rs.sequence, "currencies", len(er.rates.Load().graph))
+
 	return er, nil
 }
 
-func (e *ExchangeRates) GetRate(base, dest string) (float64, error) {
-	br, ok := e.rates[base]
-	if !ok {
-		return 0, fmt.Errorf("rate not found for currency %s", base)
+func newExchangeRates(l hclog.Logger, p RateProvider, pivot string, h *History, sim *Simulator, a *AuditLog) *ExchangeRates {
+	er := &ExchangeRates{log: l, provider: p, history: h, simulator: sim, audit: a, done: make(chan struct{})}
+	er.rates.Store(newRateSet(pivot))
+	er.spreads.Store(&SpreadRules{})
+	er.refreshed.Store(&RefreshStatus{})
+
+	return er
+}
+
+// SetSpreads replaces the spread rules, without rules bid and ask equal the mid rate
+func (e *ExchangeRates) SetSpreads(sr SpreadRules) error {
+	if err := sr.Validate(); err != nil {
+		return err
 	}
 
-	dr, ok := e.rates[dest]
-	if !ok {
-		return 0, fmt.Errorf("rate not found for currency %s", dest)
+	e.spreads.Store(&sr)
+
+	return nil
+}
+
+// GetRate returns the rate with the spread of the default customer tier
+func (e *ExchangeRates) GetRate(base, dest string) (Rate, error) {
+	return e.Quote(base, dest, "")
+}
+
+// Quote returns the rate with the spread of the given customer tier
+func (e *ExchangeRates) Quote(base, dest, tier string) (Rate, error) {
+	return e.quote(e.rates.Load(), base, dest, tier)
+}
+
+func (e *ExchangeRates) quote(rs *rateSet, base, dest, tier string) (Rate, error) {
+	r, err := rs.rate(base, dest)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	spread, err := e.spreads.Load().Spread(base, dest, tier)
+	if err != nil {
+		return Rate{}, err
 	}
 
-	return dr / br, nil
+	return r.withSpread(spread), nil
 }
 
+// GetRates returns the rate from base to every destination, all rates come
+// from the same set of rates. An unknown base or tier fails the whole lookup
+// while an unknown destination is reported in its own result
+func (e *ExchangeRates) GetRates(base string, dests []string, tier string) ([]RateResult, error) {
+	rs := e.rates.Load()
+
+	if _, ok := rs.graph[base]; !ok && base != rs.pivot {
+		return nil, fmt.Errorf("%w for currency %s", ErrRateNotFound, base)
+	}
+
+	if _, err := e.spreads.Load().Spread(base, base, tier); err != nil {
+		return nil, err
+	}
+
+	res := make([]RateResult, len(dests))
+	for i, d := range dests {
+		r, err := e.quote(rs, base, d, tier)
+		res[i] = RateResult{Destination: d, Rate: r, Err: err}
+	}
+
+	return res, nil
+}
+
+// Convert converts the amount from base to dest at the rate of the given side
+// and tier and rounds the result to the minor unit of dest, it returns the
+// converted amount and the rate used
+func (e *ExchangeRates) Convert(amount Money, base, dest, tier string, side Side, mode RoundingMode) (Money, Rate, error) {
+	rate, err := e.Quote(base, dest, tier)
+	if err != nil {
+		return Money{}, Rate{}, err
+	}
+
+	digits := 2
+	if ci, ok := e.Lookup(dest); ok {
+		digits = ci.Digits
+	}
+
+	// amounts are held in nano units
+	if digits > 9 {
+		digits = 9
+	}
+
+	m, err := ConvertAmount(amount, rate.Price(side), digits, mode)
+	if err != nil {
+		return Money{}, Rate{}, err
+	}
+
+	return m, rate, nil
+}
+
+// Currencies returns the currencies that currently have a rate, sorted by code
+func (e *ExchangeRates) Currencies() []CurrencyStatus {
+	rs := e.rates.Load()
+
+	cs := make([]CurrencyStatus, 0, len(rs.changed))
+	for k := range rs.changed {
+		ci, ok := LookupCurrency(k)
+		if u, isUnit := rs.units[k]; isUnit {
+			ci, ok = u.Info, true
+		}
+		if !ok {
+			ci = CurrencyInfo{Code: k}
+		}
+
+		cs = append(cs, CurrencyStatus{ci, rs.changed[k]})
+	}
+
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Code < cs[j].Code })
+
+	return cs
+}
+
+// Pivot returns the currency the rates are quoted against in the history
+func (e *ExchangeRates) Pivot() string {
+	return e.rates.Load().pivot
+}
+
+// PivotPath returns the path of a rate through the pivot, the path of
+// historical rates
+func (e *ExchangeRates) PivotPath(base, dest string) []string {
+	path := []string{base}
+	if p := e.Pivot(); p != base && p != dest {
+		path = append(path, p)
+	}
+
+	return append(path, dest)
+}
+
+// GetHistoricalRate returns the rate that applied at time t and the time it
+// was recorded. Historical rates are mid rates through the pivot, they
+// differ from GetRate for pairs with a direct quote, an override or a spread
+func (e *ExchangeRates) GetHistoricalRate(base, dest string, t time.Time) (RatePoint, error) {
+	return e.history.At(base, dest, t)
+}
+
+// GetRateSeries returns every rate recorded between start and end, the rates
+// go through the pivot as those of GetHistoricalRate
+func (e *ExchangeRates) GetRateSeries(base, dest string, start, end time.Time) ([]RatePoint, error) {
+	return e.history.Series(base, dest, start, end)
+}
+
+// GetCandles returns the recorded rates between start and end grouped into
+// candles of the given interval
+func (e *ExchangeRates) GetCandles(base, dest string, start, end time.Time, interval time.Duration) ([]Candle, error) {
+	points, err := e.history.Series(base, dest, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return Aggregate(points, interval), nil
+}
+
+// MonitorRates simulates changes of the rates every interval unless the
+// rates are frozen, the returned channel signals that the rates were updated. Updates are not queued, a
+// reader that falls behind gets a single signal for the missed updates. The
+// channel is closed once the rate store is closed
 func (e *ExchangeRates) MonitorRates(interval time.Duration) chan struct{} {
-	ret := make(chan struct{})
+	ret := make(chan struct{}, 1)
 
 	go func() {
+		defer close(ret)
+
 		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
 		for {
 			select {
+			case <-e.done:
+				return
 			case <-ticker.C:
-				// just add a random difference to the rate and return it
-				// this simulates the fluctuations in currency rates
-				for k, v := range e.rates {
-					// change can be 10% of original value
-					change := (rand.Float64() / 10)
-					// is this a postive or negative change
-					direction := rand.Intn(1)
-
-					if direction == 0 {
-						// new value with be min 90% of old
-						change = 1 - change
-					} else {
-						// new value will be 110% of old
-						change = 1 + change
-					}
+				if e.simulator != nil && !e.frozen.Load() {
+					e.update(CauseSimulator, func(rs *rateSet, now time.Time) {
+						// units are not simulated, they follow their pivot
+						fixed := make([]string, 0, len(rs.units))
+						for k, u := range rs.units {
+							fixed = append(fixed, pairKey(u.Pivot, k))
+						}
+						e.simulator.Step(rs.quotes, rs.provided, fixed...)
 
-					// modify the rate
-					e.rates[k] = v * change
+						for k := range rs.quotes {
+							if rs.unitQuote(k) {
+								continue
+							}
+
+							rs.updated[k] = now
+							rs.sources[k] = SimulatorSource
+						}
+					})
 				}
 
-				// notify updates, this will block unless there is a listener on the other end
-				ret <- struct{}{}
+				// notify updates without blocking the ticker
+				select {
+				case ret <- struct{}{}:
+				default:
+				}
 			}
 		}
 	}()
@@ -78,43 +533,216 @@ func (e *ExchangeRates) MonitorRates(interval time.Duration) chan struct{} {
 	return ret
 }
 
-func (e *ExchangeRates) getRates() error {
-	resp, err := http.DefaultClient.Get("https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml")
-	if err != nil {
-		return err
-	}
+// update applies fn to a copy of the current rates, publishes the copy and
+// records it in the history and the changed quotes in the audit log
+func (e *ExchangeRates) update(cause string, fn func(rs *rateSet, now time.Time)) {
+	e.updateIf(cause, func(rs *rateSet, now time.Time) bool {
+		fn(rs, now)
+		return true
+	})
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("exprected status code 200, got %d", resp.StatusCode)
+// updateIf is update for changes that may not apply, the rates are left as
+// they are when fn reports false. It reports whether the rates were updated
+func (e *ExchangeRates) updateIf(cause string, fn func(rs *rateSet, now time.Time) bool) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 
+	old := e.rates.Load()
+	rs := old.clone()
+	rs.sequence++
+	now := time.Now()
+	if !fn(rs, now) {
+		return false
 	}
-	defer resp.Body.Close()
+	rs.derive()
 
-	md := &Cubes{}
-	err = xml.NewDecoder(resp.Body).Decode(&md)
-	if err != nil {
-		return err
-	}
+	e.rates.Store(rs)
+	e.history.Record(now, rs.rates)
 
-	for _, c := range md.CubeData {
-		r, err := strconv.ParseFloat(c.Rate, 64)
+	if e.audit != nil {
+		err := e.audit.Append(auditEntries(old, rs, cause, now))
 		if err != nil {
-			return err
+			e.log.Error("Unable to record rate changes in the audit log", "cause", cause, "sequence", rs.sequence, "error", err)
 		}
+	}
 
-		e.rates[c.Currency] = r
+	return true
+}
+
+// AuditEntries returns the entries of the audit log between start and end
+// of the quotes that involve any of the codes
+func (e *ExchangeRates) AuditEntries(codes []string, start, end time.Time) ([]AuditEntry, error) {
+	if e.audit == nil {
+		return nil, ErrNoAuditLog
 	}
 
-	e.rates["EUR"] = 1
+	return e.audit.Query(codes, start, end)
+}
+
+// RefreshStatus returns the outcome of the refreshes from the provider
+func (e *ExchangeRates) RefreshStatus() RefreshStatus {
+	return *e.refreshed.Load()
+}
+
+// ProviderHealth returns the health of the providers of a provider chain,
+// other providers report none
+func (e *ExchangeRates) ProviderHealth() []ProviderHealth {
+	if c, ok := e.provider.(*ProviderChain); ok {
+		return c.Health()
+	}
 
 	return nil
 }
 
-type Cubes struct {
-	CubeData []Cube `xml:"Cube>Cube>Cube"`
+// Refresh reloads the rates from the provider and the unit sources, it
+// applies even while the rates are frozen. The rates are kept as they are
+// when the refresh fails
+func (e *ExchangeRates) Refresh() error {
+	return e.getRates()
+}
+
+// loadRetry is the first wait before a retry while the rates were never
+// loaded from the provider, it doubles up to the refresh interval
+const loadRetry = 5 * time.Second
+
+// RefreshRates reloads the rates from the provider and the unit sources every
+// interval unless the rates are frozen. When the provider fails the last good
+// rates are served until a later refresh succeeds, until the first success it
+// is retried sooner
+func (e *ExchangeRates) RefreshRates(interval time.Duration) {
+	go func() {
+		retry := loadRetry
+		for {
+			wait := interval
+			if e.RefreshStatus().Time.IsZero() && retry < interval {
+				wait = retry
+				retry *= 2
+			}
+			t := time.NewTimer(wait)
+			select {
+			case <-e.done:
+				t.Stop()
+				return
+			case <-t.C:
+			}
+
+			if e.frozen.Load() {
+				continue
+			}
+
+			err := e.getRates()
+			if err != nil {
+				e.log.Error("Unable to refresh rates, serving the last rates", "updated", e.RefreshStatus().Time, "error", err)
+			}
+		}
+	}()
+}
+
+// Close stops the simulation, the periodic refresh and the snapshots of the
+// rate store, the rates are still served
+func (e *ExchangeRates) Close() {
+	e.closeOnce.Do(func() { close(e.done) })
+}
+
+// Freeze stops or resumes the changes of the simulator and of the periodic
+// refresh, overrides and forced refreshes still apply while the rates are frozen
+func (e *ExchangeRates) Freeze(frozen bool) {
+	e.frozen.Store(frozen)
+	e.log.Info("Rates frozen", "frozen", frozen)
+}
+
+// Frozen reports whether the automatic changes of the rates are stopped
+func (e *ExchangeRates) Frozen() bool {
+	return e.frozen.Load()
+}
+
+func (e *ExchangeRates) getRates() error {
+	var refreshed time.Time
+	rates, name, err := fetchRates(e.provider)
+	if err == nil {
+		var quotes map[string]float64
+		quotes, err = providerQuotes(rates)
+		if err == nil {
+			e.update(CauseRefresh, func(rs *rateSet, now time.Time) {
+				// the quotes the provider stopped returning are removed
+				for k := range rs.provided {
+					if _, ok := quotes[k]; !ok {
+						delete(rs.quotes, k)
+						delete(rs.updated, k)
+						delete(rs.sources, k)
+					}
+				}
+
+				for k, v := range quotes {
+					rs.quotes[k] = v
+					rs.updated[k] = now
+					rs.sources[k] = name
+				}
+
+				rs.provided = quotes
+				refreshed = now
+			})
+
+			e.log.Info("Loaded rates", "provider", name, "quotes", len(quotes), "currencies", len(e.rates.Load().graph))
+		}
+	}
+
+	// concurrent refreshes may record their outcome out of order but never
+	// lose the outcome of another
+	for {
+		old := e.refreshed.Load()
+		st := *old
+		st.Attempted, st.Err = time.Now(), err
+		if err == nil {
+			st.Provider, st.Time = name, refreshed
+		}
+
+		if e.refreshed.CompareAndSwap(old, &st) {
+			break
+		}
+	}
+
+	e.refreshUnits()
+
+	return err
+}
+
+// fetchRates returns the rates of a provider and the name of the provider
+// that served them, a chain names the provider of the chain that succeeded
+func fetchRates(p RateProvider) (map[string]float64, string, error) {
+	if c, ok := p.(*ProviderChain); ok {
+		return c.Fetch()
+	}
+
+	rates, err := p.Rates()
+	return rates, p.Name(), err
 }
 
-type Cube struct {
-	Currency string `xml:"currency,attr"`
-	Rate     string `xml:"rate,attr"`
+// providerQuotes returns the quotes of a provider by pair, codes without a
+// base are quoted against EUR
+func providerQuotes(rates map[string]float64) (map[string]float64, error) {
+	quotes := make(map[string]float64, len(rates))
+	for k, v := range rates {
+		if !strings.Contains(k, "/") {
+			k = pairKey("EUR", k)
+		}
+
+		b, d := splitPair(k)
+		if b == d {
+			continue
+		}
+
+		if b == "" || d == "" {
+			return nil, fmt.Errorf("invalid pair %q", k)
+		}
+
+		if v <= 0 {
+			return nil, fmt.Errorf("rate of %s must be positive, got %f", k, v)
+		}
+
+		quotes[k] = v
+	}
+
+	return quotes, nil
 }
@@ -1,17 +1,258 @@
 package data
 
 import (
-	"fmt"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 )
 
+// newTestRates creates a simulated rate store, it is closed with the test
+func newTestRates(t *testing.T) *ExchangeRates {
+	t.Helper()
+
+	sim, err := NewSimulator(RandomWalk{Bound: 0.1}, 0.01, nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr := NewRates(hclog.NewNullLogger(), NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160, "GBP": 0.85}), "EUR", NewHistory(0), sim, nil)
+	t.Cleanup(tr.Close)
+
+	return tr
+}
+
 func TestNewRates(t *testing.T) {
-	tr, err := NewRates(hclog.Default())
+	tr := newTestRates(t)
+
+	if len(tr.rates.Load().rates) != 4 {
+		t.Fatalf("expected 4 rates, got %#v", tr.rates.Load().rates)
+	}
+
+	if tr.rates.Load().rates["EUR"] != 1 {
+		t.Fatalf("expected EUR to be the base with rate 1, got %f", tr.rates.Load().rates["EUR"])
+	}
+}
+
+func TestGetRate(t *testing.T) {
+	tr := newTestRates(t)
+
+	r, err := tr.GetRate("USD", "GBP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-0.85/1.1) > 1e-9 {
+		t.Fatalf("expected %f, got %f", 0.85/1.1, r.Value)
+	}
+
+	if r.Source != "fixed" || r.AsOf.IsZero() || r.Sequence != 1 {
+		t.Fatalf("unexpected metadata %#v", r)
+	}
+
+	_, err = tr.GetRate("USD", "XXX")
+	if err == nil {
+		t.Fatal("expected an error for an unknown currency")
+	}
+}
+
+func TestCurrencies(t *testing.T) {
+	tr := newTestRates(t)
+
+	cs := tr.Currencies()
+	if len(cs) != 4 {
+		t.Fatalf("expected 4 currencies, got %d", len(cs))
+	}
+
+	// sorted by code
+	if cs[0].Code != "EUR" || cs[2].Code != "JPY" {
+		t.Fatalf("unexpected order %#v", cs)
+	}
+
+	if cs[2].Digits != 0 || cs[2].Name != "Yen" || cs[2].Updated.IsZero() {
+		t.Fatalf("unexpected metadata for JPY %#v", cs[2])
+	}
+}
+
+func TestGetRates(t *testing.T) {
+	tr := newTestRates(t)
+
+	res, err := tr.GetRates("EUR", []string{"USD", "XXX", "JPY"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(res))
+	}
+
+	if res[0].Rate.Value != 1.1 || res[0].Err != nil {
+		t.Fatalf("unexpected result for USD %#v", res[0])
+	}
+
+	if res[1].Err == nil {
+		t.Fatal("expected an error for an unknown destination")
+	}
+
+	if res[2].Rate.Value != 160 || res[2].Err != nil {
+		t.Fatalf("unexpected result for JPY %#v", res[2])
+	}
+
+	_, err = tr.GetRates("XXX", []string{"USD"}, "")
+	if err == nil {
+		t.Fatal("expected an error for an unknown base")
+	}
+}
+
+// TestConcurrentAccess reads rates while they are updated, run it with -race
+// to detect data races
+func TestConcurrentAccess(t *testing.T) {
+	tr := newTestRates(t)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	ru := tr.MonitorRates(time.Millisecond)
+	go func() {
+		for {
+			select {
+			case <-ru:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 500; j++ {
+				_, err := tr.GetRate("USD", "JPY")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				_, err = tr.GetRates("EUR", []string{"USD", "GBP"}, "")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				tr.Currencies()
+
+				if j%100 == 0 {
+					err = tr.getRates()
+					if err != nil {
+						t.Error(err)
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestRateMetadata(t *testing.T) {
+	tr := newTestRates(t)
+	before, _ := tr.GetRate("EUR", "USD")
+
+	ru := tr.MonitorRates(time.Millisecond)
+	<-ru
+
+	after, err := tr.GetRate("EUR", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if after.Source != SimulatorSource {
+		t.Fatalf("expected the simulator to be the source, got %s", after.Source)
+	}
+
+	if after.Sequence <= before.Sequence || after.AsOf.Before(before.AsOf) {
+		t.Fatalf("expected a newer rate, got %#v after %#v", after, before)
+	}
+}
+
+func TestRefreshRemovesQuotes(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rates.json")
+	err := os.WriteFile(path, []byte(`{"USD": 1.1, "JPY": 160}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := OpenAuditLog(filepath.Join(dir, "audit.log"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { a.Close() })
+
+	tr := NewRates(hclog.NewNullLogger(), NewFileProvider(path), "EUR", NewHistory(0), nil, a)
+
+	// the provider stops quoting JPY
+	err = os.WriteFile(path, []byte(`{"USD": 1.2}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tr.GetRate("EUR", "JPY")
+	if !errors.Is(err, ErrRateNotFound) {
+		t.Fatalf("expected the JPY quote to be removed, got %v", err)
+	}
+
+	// the removal is replayed from the audit log
+	entries, err := ReadAuditLog(filepath.Join(dir, "audit.log"))
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	fmt.Printf("Rates: %#v\n", tr.rates)
+	rr, err := ReplayRates(hclog.NewNullLogger(), NewFixedProvider(nil), "EUR", NewHistory(0), nil, nil, nil, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rr.GetRate("EUR", "JPY"); !errors.Is(err, ErrRateNotFound) {
+		t.Fatalf("expected no JPY quote after the replay, got %v", err)
+	}
+
+	if r, _ := rr.GetRate("EUR", "USD"); r.Value != 1.2 {
+		t.Fatalf("expected the refreshed USD quote, got %#v", r)
+	}
+}
+
+func TestCloseStopsMonitor(t *testing.T) {
+	tr := newTestRates(t)
+
+	ru := tr.MonitorRates(time.Millisecond)
+	<-ru
+
+	tr.Close()
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ru:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("expected the channel to be closed with the rate store")
+		}
+	}
 }
@@ -0,0 +1,157 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Model computes the next simulated rate of a currency
+type Model interface {
+	// Next returns the rate following current, source is the last rate
+	// loaded from the provider and vol the volatility per step
+	Next(rnd *rand.Rand, current, source, vol float64) float64
+}
+
+// RandomWalk moves the rate by a uniformly distributed relative change of
+// at most vol, the rate is kept within Bound of the source rate
+type RandomWalk struct {
+	Bound float64
+}
+
+func (m RandomWalk) Next(rnd *rand.Rand, current, source, vol float64) float64 {
+	next := current * (1 + vol*(2*rnd.Float64()-1))
+
+	if m.Bound > 0 {
+		next = math.Max(next, source*(1-m.Bound))
+		next = math.Min(next, source*(1+m.Bound))
+	}
+
+	return next
+}
+
+// MeanReversion pulls the rate towards the source rate with the given
+// Speed (0-1) while adding normally distributed noise
+type MeanReversion struct {
+	Speed float64
+}
+
+func (m MeanReversion) Next(rnd *rand.Rand, current, source, vol float64) float64 {
+	next := current + m.Speed*(source-current) + current*vol*rnd.NormFloat64()
+
+	// a rate can never become negative
+	return math.Max(next, current*0.01)
+}
+
+// GeometricBrownianMotion changes the rate by a log-normally distributed
+// factor with the given Drift per step
+type GeometricBrownianMotion struct {
+	Drift float64
+}
+
+func (m GeometricBrownianMotion) Next(rnd *rand.Rand, current, source, vol float64) float64 {
+	return current * math.Exp(m.Drift-vol*vol/2+vol*rnd.NormFloat64())
+}
+
+// NewModel creates a model by name: random-walk, mean-reversion or gbm,
+// param is the bound, the reversion speed or the drift respectively
+func NewModel(name string, param float64) (Model, error) {
+	if math.IsNaN(param) || math.IsInf(param, 0) {
+		return nil, fmt.Errorf("parameter of the %s model must be finite, got %f", name, param)
+	}
+
+	switch name {
+	case "random-walk":
+		if param < 0 {
+			return nil, fmt.Errorf("random walk bound must not be negative, got %f", param)
+		}
+		return RandomWalk{Bound: param}, nil
+	case "mean-reversion":
+		if param < 0 || param > 1 {
+			return nil, fmt.Errorf("mean reversion speed must be between 0 and 1, got %f", param)
+		}
+		return MeanReversion{Speed: param}, nil
+	case "gbm":
+		return GeometricBrownianMotion{Drift: param}, nil
+	}
+
+	return nil, fmt.Errorf("unknown simulation model %q", name)
+}
+
+// Simulator produces fluctuations of the rates, the same seed replays the
+// same fluctuations. It is not safe for concurrent use
+type Simulator struct {
+	model      Model
+	rnd        *rand.Rand
+	volatility float64
+	currency   map[string]float64
+}
+
+// NewSimulator creates a simulator, volatility applies to every currency
+// without its own entry in currencyVolatility. A volatility must be at least
+// 0 and below 1 for the rates to stay positive. A zero seed picks a random one
+func NewSimulator(model Model, volatility float64, currencyVolatility map[string]float64, seed int64) (*Simulator, error) {
+	if !validVolatility(volatility) {
+		return nil, fmt.Errorf("volatility must be at least 0 and below 1, got %f", volatility)
+	}
+
+	for k, v := range currencyVolatility {
+		if !validVolatility(v) {
+			return nil, fmt.Errorf("volatility of %s must be at least 0 and below 1, got %f", k, v)
+		}
+	}
+
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	cv := make(map[string]float64, len(currencyVolatility))
+	for k, v := range currencyVolatility {
+		cv[k] = v
+	}
+
+	return &Simulator{model, rand.New(rand.NewSource(seed)), volatility, cv}, nil
+}
+
+func validVolatility(vol float64) bool {
+	return vol >= 0 && vol < 1
+}
+
+// Step moves every rate except the fixed ones such as the pivot one step
+// forward, source holds the rates last loaded from the provider
+func (s *Simulator) Step(rates, source map[string]float64, fixed ...string) {
+	skip := make(map[string]bool, len(fixed))
+	for _, k := range fixed {
+		skip[k] = true
+	}
+
+	// iterate in a fixed order so a seed always gives the same results
+	codes := make([]string, 0, len(rates))
+	for k := range rates {
+		if !skip[k] {
+			codes = append(codes, k)
+		}
+	}
+	sort.Strings(codes)
+
+	for _, k := range codes {
+		// the volatility of a currency applies to its quotes
+		vol, ok := s.currency[k]
+		if _, d, pair := strings.Cut(k, "/"); !ok && pair {
+			vol, ok = s.currency[d]
+		}
+		if !ok {
+			vol = s.volatility
+		}
+
+		src, ok := source[k]
+		if !ok {
+			src = rates[k]
+		}
+
+		rates[k] = s.model.Next(s.rnd, rates[k], src, vol)
+	}
+}
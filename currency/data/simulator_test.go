@@ -0,0 +1,105 @@
+package data
+
+import (
+	"math"
+	"testing"
+)
+
+func simulate(s *Simulator, steps int) map[string]float64 {
+	rates := map[string]float64{"EUR": 1, "USD": 1.1, "JPY": 160}
+	source := map[string]float64{"EUR": 1, "USD": 1.1, "JPY": 160}
+
+	for i := 0; i < steps; i++ {
+		s.Step(rates, source, "EUR")
+	}
+
+	return rates
+}
+
+func newTestSimulator(t *testing.T, m Model, vol float64, cv map[string]float64, seed int64) *Simulator {
+	t.Helper()
+
+	s, err := NewSimulator(m, vol, cv, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestSimulatorSeed(t *testing.T) {
+	for _, m := range []Model{RandomWalk{Bound: 0.1}, MeanReversion{Speed: 0.1}, GeometricBrownianMotion{}} {
+		a := simulate(newTestSimulator(t, m, 0.01, nil, 42), 100)
+		b := simulate(newTestSimulator(t, m, 0.01, nil, 42), 100)
+
+		for k := range a {
+			if a[k] != b[k] {
+				t.Fatalf("%T: expected the same seed to replay the same rates, got %f and %f for %s", m, a[k], b[k], k)
+			}
+		}
+
+		if a["EUR"] != 1 {
+			t.Fatalf("%T: the pivot rate must not change, got %f", m, a["EUR"])
+		}
+	}
+}
+
+func TestRandomWalkBound(t *testing.T) {
+	rates := simulate(newTestSimulator(t, RandomWalk{Bound: 0.05}, 0.5, nil, 1), 1000)
+
+	if rates["USD"] < 1.1*0.95-1e-9 || rates["USD"] > 1.1*1.05+1e-9 {
+		t.Fatalf("expected USD to stay within 5%% of 1.1, got %f", rates["USD"])
+	}
+}
+
+func TestMeanReversion(t *testing.T) {
+	rates := map[string]float64{"EUR": 1, "USD": 2}
+	source := map[string]float64{"EUR": 1, "USD": 1}
+
+	s := newTestSimulator(t, MeanReversion{Speed: 0.5}, 0, nil, 1)
+	for i := 0; i < 50; i++ {
+		s.Step(rates, source, "EUR")
+	}
+
+	if math.Abs(rates["USD"]-1) > 1e-6 {
+		t.Fatalf("expected USD to revert to 1, got %f", rates["USD"])
+	}
+}
+
+func TestCurrencyVolatility(t *testing.T) {
+	rates := simulate(newTestSimulator(t, GeometricBrownianMotion{}, 0.01, map[string]float64{"JPY": 0}, 1), 100)
+
+	if rates["JPY"] != 160 {
+		t.Fatalf("expected JPY with zero volatility to stay at 160, got %f", rates["JPY"])
+	}
+
+	if rates["USD"] == 1.1 {
+		t.Fatal("expected USD to change")
+	}
+}
+
+func TestSimulatorInvalid(t *testing.T) {
+	for _, vol := range []float64{-0.1, 1, 1.5, math.NaN()} {
+		if _, err := NewSimulator(RandomWalk{}, vol, nil, 1); err == nil {
+			t.Errorf("expected an error for a volatility of %f", vol)
+		}
+
+		if _, err := NewSimulator(RandomWalk{}, 0.01, map[string]float64{"JPY": vol}, 1); err == nil {
+			t.Errorf("expected an error for a JPY volatility of %f", vol)
+		}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		param float64
+	}{
+		{"random-walk", -0.1},
+		{"mean-reversion", 1.5},
+		{"gbm", math.Inf(1)},
+		{"gbm", math.NaN()},
+	} {
+		if _, err := NewModel(tc.name, tc.param); err == nil {
+			t.Errorf("expected an error for %s with %f", tc.name, tc.param)
+		}
+	}
+}
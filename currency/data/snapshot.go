@@ -0,0 +1,339 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// SnapshotVersion is the version of the snapshots written by the rate store
+const SnapshotVersion = 1
+
+// ErrNoSnapshot is returned when there is no snapshot to load
+var ErrNoSnapshot = errors.New("no snapshot")
+
+// snapshotMigrations upgrade older snapshots, the migration at index i
+// upgrades a document of version i+1 to version i+2
+var snapshotMigrations []func(doc map[string]json.RawMessage) error
+
+// Snapshot is the state of the rate store at a point in time, it is written
+// to a file so that a restart can serve rates before the provider is reached
+type Snapshot struct {
+	Version  int             `json:"version"`
+	Time     time.Time       `json:"time"`
+	Pivot    string          `json:"pivot"`
+	Sequence uint64          `json:"sequence"`
+	Quotes   []SnapshotQuote `json:"quotes"`
+	// Provided holds the quotes as last loaded from the provider, the
+	// simulator reverts towards them
+	Provided  map[string]float64 `json:"provided"`
+	Units     []SnapshotUnit     `json:"units"`
+	Overrides []Override         `json:"overrides"`
+}
+
+// SnapshotQuote is a quote of the rate store with its provenance
+type SnapshotQuote struct {
+	Pair    string    `json:"pair"`
+	Rate    float64   `json:"rate"`
+	Updated time.Time `json:"updated"`
+	Source  string    `json:"source"`
+}
+
+// SnapshotUnit is a unit of the rate store and the pivot it is quoted against
+type SnapshotUnit struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Digits int    `json:"digits"`
+	Symbol string `json:"symbol"`
+	Pivot  string `json:"pivot"`
+}
+
+// Snapshot returns the current state of the rate store
+func (e *ExchangeRates) Snapshot() *Snapshot {
+	rs := e.rates.Load()
+
+	s := &Snapshot{
+		Version:   SnapshotVersion,
+		Time:      time.Now(),
+		Pivot:     rs.pivot,
+		Sequence:  rs.sequence,
+		Quotes:    make([]SnapshotQuote, 0, len(rs.quotes)),
+		Provided:  rs.provided,
+		Units:     make([]SnapshotUnit, 0, len(rs.units)),
+		Overrides: e.Overrides(),
+	}
+
+	for k, v := range rs.quotes {
+		s.Quotes = append(s.Quotes, SnapshotQuote{k, v, rs.updated[k], rs.sources[k]})
+	}
+	sort.Slice(s.Quotes, func(i, j int) bool { return s.Quotes[i].Pair < s.Quotes[j].Pair })
+
+	for _, u := range rs.units {
+		s.Units = append(s.Units, SnapshotUnit{u.Info.Code, u.Info.Name, u.Info.Digits, u.Info.Symbol, u.Pivot})
+	}
+	sort.Slice(s.Units, func(i, j int) bool { return s.Units[i].Code < s.Units[j].Code })
+
+	return s
+}
+
+// SnapshotRates writes a snapshot of the rates to path every interval, a
+// snapshot is only written when the rates changed since the last one. The
+// audit log is rotated after every snapshot, the snapshot and the entries
+// since the rotation are enough to replay the rates. The snapshots stop when
+// the rate store is closed
+func (e *ExchangeRates) SnapshotRates(path string, interval time.Duration) {
+	go func() {
+		var written uint64
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-e.done:
+				return
+			case <-ticker.C:
+			}
+
+			s := e.Snapshot()
+			if s.Sequence == written {
+				continue
+			}
+
+			err := WriteSnapshot(path, s)
+			if err != nil {
+				e.log.Error("Unable to write rate snapshot", "path", path, "error", err)
+				continue
+			}
+
+			written = s.Sequence
+
+			if e.audit != nil {
+				err = e.audit.Rotate()
+				if err != nil {
+					e.log.Error("Unable to rotate the audit log", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// WriteSnapshot writes the snapshot to path atomically, the file is written
+// aside and renamed over the previous snapshot which is kept as path.prev
+func WriteSnapshot(path string, s *Snapshot) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(b)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(path, path+".prev")
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return err
+	}
+
+	// the renames are durable once the directory is synced
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
+
+// LoadSnapshot returns the newest valid snapshot of path and path.prev,
+// older versions are migrated. It returns ErrNoSnapshot if neither exists
+func LoadSnapshot(path string) (*Snapshot, error) {
+	var newest *Snapshot
+	var errs []error
+	for _, p := range []string{path, path + ".prev"} {
+		s, err := readSnapshot(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+
+		if newest == nil || s.Time.After(newest.Time) {
+			newest = s
+		}
+	}
+
+	if newest != nil {
+		return newest, nil
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return nil, ErrNoSnapshot
+}
+
+func readSnapshot(path string) (*Snapshot, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = migrateSnapshot(b)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{}
+	err = json.Unmarshal(b, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, s.validate()
+}
+
+// migrateSnapshot upgrades a snapshot document to the current version
+func migrateSnapshot(b []byte) ([]byte, error) {
+	doc := map[string]json.RawMessage{}
+	err := json.Unmarshal(b, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var version int
+	err = json.Unmarshal(doc["version"], &version)
+	if err != nil || version < 1 {
+		return nil, fmt.Errorf("snapshot has no valid version")
+	}
+
+	if version > SnapshotVersion {
+		return nil, fmt.Errorf("snapshot version %d is newer than the supported version %d", version, SnapshotVersion)
+	}
+
+	if version == SnapshotVersion {
+		return b, nil
+	}
+
+	for ; version < SnapshotVersion; version++ {
+		err = snapshotMigrations[version-1](doc)
+		if err != nil {
+			return nil, fmt.Errorf("unable to migrate snapshot version %d: %w", version, err)
+		}
+	}
+
+	doc["version"], _ = json.Marshal(version)
+
+	return json.Marshal(doc)
+}
+
+func (s *Snapshot) validate() error {
+	if s.Pivot == "" {
+		return fmt.Errorf("snapshot has no pivot")
+	}
+
+	if len(s.Quotes) == 0 {
+		return fmt.Errorf("snapshot has no quotes")
+	}
+
+	for _, q := range s.Quotes {
+		b, d := splitPair(q.Pair)
+		if b == "" || d == "" || b == d {
+			return fmt.Errorf("invalid pair %q", q.Pair)
+		}
+
+		if q.Rate <= 0 {
+			return fmt.Errorf("rate of %s must be positive, got %f", q.Pair, q.Rate)
+		}
+	}
+
+	for _, o := range s.Overrides {
+		if o.Base == "" || o.Dest == "" || o.Base == o.Dest || o.Rate <= 0 {
+			return fmt.Errorf("invalid override of %s", pairKey(o.Base, o.Dest))
+		}
+	}
+
+	return nil
+}
+
+// rateSet returns the rates of the snapshot
+func (s *Snapshot) rateSet() *rateSet {
+	rs := newRateSet(s.Pivot)
+	rs.sequence = s.Sequence
+
+	for _, q := range s.Quotes {
+		rs.quotes[q.Pair] = q.Rate
+		rs.updated[q.Pair] = q.Updated
+		rs.sources[q.Pair] = q.Source
+	}
+
+	for k, v := range s.Provided {
+		rs.provided[k] = v
+	}
+
+	for _, u := range s.Units {
+		rs.units[u.Code] = unit{CurrencyInfo{u.Code, u.Name, u.Digits, u.Symbol}, u.Pivot}
+	}
+
+	for _, o := range s.Overrides {
+		rs.overrides[pairKey(o.Base, o.Dest)] = o
+	}
+
+	rs.derive()
+
+	return rs
+}
+
+// RestoreRates creates the rate store from a snapshot and loads the rates
+// from the provider. When the provider fails the rates of the snapshot are
+// served until a refresh succeeds. Overrides that expired since the snapshot
+// was taken are removed
+func RestoreRates(l hclog.Logger, p RateProvider, pivot string, h *History, sim *Simulator, a *AuditLog, s *Snapshot) (*ExchangeRates, error) {
+	if s.Pivot != pivot {
+		return nil, fmt.Errorf("snapshot is quoted against %s, the pivot is %s", s.Pivot, pivot)
+	}
+
+	er := newExchangeRates(l, p, pivot, h, sim, a)
+
+	rs := s.rateSet()
+	er.rates.Store(rs)
+	er.refreshed.Store(&RefreshStatus{Restored: s.Time})
+	h.Record(s.Time, rs.rates)
+	er.scheduleOverrides()
+
+	l.Info("Restored rates", "snapshot", s.Time, "sequence", rs.sequence, "currencies", len(rs.graph))
+
+	err := er.getRates()
+	if err != nil {
+		l.Error("Unable to load rates, serving the snapshot until a refresh succeeds", "snapshot", s.Time, "error", err)
+	}
+
+	return er, nil
+}
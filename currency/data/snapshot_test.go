@@ -0,0 +1,162 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestRestoreRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.snapshot")
+
+	tr := newTestRates(t)
+	err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0.00002}), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, err := tr.SetOverride("EUR", "GBP", 0.9, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tr.SetOverride("EUR", "JPY", 150, 20*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WriteSnapshot(path, tr.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.Overrides) != 2 {
+		t.Fatalf("expected both overrides in the snapshot, got %#v", s.Overrides)
+	}
+
+	// the provider is down, the snapshot is served
+	p := &flakyProvider{name: "primary", rates: map[string]float64{"USD": 1.2, "JPY": 170}, fail: true}
+	time.Sleep(30 * time.Millisecond)
+	rr, err := RestoreRates(hclog.NewNullLogger(), p, "EUR", NewHistory(0), nil, nil, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := rr.GetRate("BTC", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := 50000 / 1.1 * 160; math.Abs(r.Value-want) > 1e-6 || r.Source != "fixed" {
+		t.Fatalf("expected %f JPY per BTC from the snapshot, got %#v", want, r)
+	}
+
+	// the override that expired since the snapshot is removed
+	if ovs := rr.Overrides(); len(ovs) != 1 || ovs[0].Base != o.Base || !ovs[0].Set.Equal(o.Set) {
+		t.Fatalf("expected the EUR/GBP override, got %#v", ovs)
+	}
+
+	if _, ok := rr.Lookup("BTC"); !ok {
+		t.Fatal("expected the BTC unit to be restored")
+	}
+
+	if st := rr.RefreshStatus(); st.Err == nil || !st.Time.IsZero() {
+		t.Fatalf("expected a failed refresh, got %#v", st)
+	}
+
+	// a refresh replaces the rates of the snapshot
+	p.set(false)
+	err = rr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, _ = rr.GetRate("EUR", "USD")
+	if r.Value != 1.2 || r.Source != "primary" || r.Sequence <= s.Sequence {
+		t.Fatalf("expected the rate of the provider after the snapshot, got %#v", r)
+	}
+}
+
+func TestRestoreRatesPivot(t *testing.T) {
+	tr := newTestRates(t)
+
+	_, err := RestoreRates(hclog.NewNullLogger(), NewFixedProvider(nil), "USD", NewHistory(0), nil, nil, tr.Snapshot())
+	if err == nil {
+		t.Fatal("expected an error for a snapshot of another pivot")
+	}
+}
+
+func TestLoadSnapshotPrevious(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.snapshot")
+
+	_, err := LoadSnapshot(path)
+	if !errors.Is(err, ErrNoSnapshot) {
+		t.Fatalf("expected ErrNoSnapshot, got %v", err)
+	}
+
+	tr := newTestRates(t)
+	first := tr.Snapshot()
+	err = WriteSnapshot(path, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr.SetOverride("EUR", "USD", 2, 0)
+	err = WriteSnapshot(path, tr.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a torn write of the newest snapshot falls back to the previous one
+	err = os.WriteFile(path, []byte(`{"version":1,"time":`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Sequence != first.Sequence || len(s.Overrides) != 0 {
+		t.Fatalf("expected the previous snapshot, got %#v", s)
+	}
+
+	err = os.WriteFile(path+".prev", []byte(`{}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = LoadSnapshot(path)
+	if err == nil || errors.Is(err, ErrNoSnapshot) {
+		t.Fatalf("expected the errors of both snapshots, got %v", err)
+	}
+}
+
+func TestMigrateSnapshot(t *testing.T) {
+	for name, in := range map[string]string{
+		"no version":    `{"pivot":"EUR"}`,
+		"newer version": `{"version":99,"pivot":"EUR"}`,
+		"not an object": `[]`,
+	} {
+		if _, err := migrateSnapshot([]byte(in)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	in := `{"version":1,"pivot":"EUR"}`
+	out, err := migrateSnapshot([]byte(in))
+	if err != nil || string(out) != in {
+		t.Fatalf("expected the current version unchanged, got %s %v", out, err)
+	}
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrUnknownTier is returned for a customer tier without spread rules
+var ErrUnknownTier = fmt.Errorf("unknown customer tier")
+
+// Side selects which price of a rate is quoted
+type Side int
+
+const (
+	// SideMid is the mid-market rate without a spread
+	SideMid Side = iota
+	// SideBid is the rate at which the destination currency is bought
+	SideBid
+	// SideAsk is the rate at which the destination currency is sold
+	SideAsk
+)
+
+// SpreadRules decide the relative spread between the bid and the ask rate.
+// A pair rule takes priority over the currency rules, the larger spread of
+// the two currencies applies, then the default. The spread is scaled by the
+// multiplier of the customer tier
+type SpreadRules struct {
+	Default float64
+	// Currency holds spreads by currency code
+	Currency map[string]float64
+	// Pair holds spreads by pair in the form "EUR/USD", a rule applies in both directions
+	Pair map[string]float64
+	// Tier holds spread multipliers by customer tier, the empty tier uses a multiplier of 1
+	Tier map[string]float64
+}
+
+// Validate checks that no spread or multiplier is negative and that
+// spreads are below 100%, also once scaled by the multiplier of a tier
+func (sr SpreadRules) Validate() error {
+	check := func(name string, v float64) error {
+		if v < 0 || v >= 1 {
+			return fmt.Errorf("spread %s of %f must be between 0 and 1", name, v)
+		}
+		return nil
+	}
+
+	if err := check("default", sr.Default); err != nil {
+		return err
+	}
+
+	for k, v := range sr.Currency {
+		if err := check(k, v); err != nil {
+			return err
+		}
+	}
+
+	for k, v := range sr.Pair {
+		if err := check(k, v); err != nil {
+			return err
+		}
+	}
+
+	// any rule can apply to a tier, the widest spread bounds the multiplier
+	widest := sr.Default
+	for _, rules := range []map[string]float64{sr.Currency, sr.Pair} {
+		for _, v := range rules {
+			widest = math.Max(widest, v)
+		}
+	}
+
+	for k, v := range sr.Tier {
+		if v < 0 {
+			return fmt.Errorf("multiplier of tier %s cannot be negative", k)
+		}
+
+		if widest*v >= 1 {
+			return fmt.Errorf("multiplier %f of tier %s scales the spread of %f to 100%% or more", v, k, widest)
+		}
+	}
+
+	return nil
+}
+
+// Spread returns the relative spread for a pair and a customer tier
+func (sr SpreadRules) Spread(base, dest, tier string) (float64, error) {
+	m := 1.0
+	if tier != "" {
+		v, ok := sr.Tier[strings.ToUpper(tier)]
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", ErrUnknownTier, tier)
+		}
+		m = v
+	}
+
+	if v, ok := sr.Pair[base+"/"+dest]; ok {
+		return v * m, nil
+	}
+
+	if v, ok := sr.Pair[dest+"/"+base]; ok {
+		return v * m, nil
+	}
+
+	bv, bok := sr.Currency[base]
+	dv, dok := sr.Currency[dest]
+	if bok || dok {
+		if dv > bv {
+			bv = dv
+		}
+		return bv * m, nil
+	}
+
+	return sr.Default * m, nil
+}
+
+// Price returns the rate of the given side
+func (r Rate) Price(side Side) float64 {
+	switch side {
+	case SideBid:
+		return r.Bid
+	case SideAsk:
+		return r.Ask
+	}
+
+	return r.Value
+}
+
+// withSpread sets the bid and ask rates around the mid rate
+func (r Rate) withSpread(spread float64) Rate {
+	r.Bid = r.Value * (1 - spread/2)
+	r.Ask = r.Value * (1 + spread/2)
+
+	return r
+}
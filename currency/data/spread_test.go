@@ -0,0 +1,100 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSpreadRules(t *testing.T) {
+	sr := SpreadRules{
+		Default:  0.002,
+		Currency: map[string]float64{"JPY": 0.004, "GBP": 0.003},
+		Pair:     map[string]float64{"EUR/USD": 0.001},
+		Tier:     map[string]float64{"WHOLESALE": 0.5},
+	}
+
+	tests := []struct {
+		name       string
+		base, dest string
+		tier       string
+		want       float64
+	}{
+		{"pair", "EUR", "USD", "", 0.001},
+		{"reversed pair", "USD", "EUR", "", 0.001},
+		{"currency", "EUR", "JPY", "", 0.004},
+		{"larger currency spread", "GBP", "JPY", "", 0.004},
+		{"default", "EUR", "CHF", "", 0.002},
+		{"tier", "EUR", "JPY", "wholesale", 0.002},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sr.Spread(tc.base, tc.dest, tc.tier)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if math.Abs(got-tc.want) > 1e-12 {
+				t.Fatalf("expected spread %f, got %f", tc.want, got)
+			}
+		})
+	}
+
+	_, err := sr.Spread("EUR", "USD", "retail")
+	if !errors.Is(err, ErrUnknownTier) {
+		t.Fatalf("expected ErrUnknownTier, got %v", err)
+	}
+}
+
+func TestSpreadRulesValidate(t *testing.T) {
+	if err := (SpreadRules{Default: 1}).Validate(); err == nil {
+		t.Fatal("expected an error for a spread of 100%")
+	}
+
+	if err := (SpreadRules{Pair: map[string]float64{"EUR/USD": -0.1}}).Validate(); err == nil {
+		t.Fatal("expected an error for a negative spread")
+	}
+
+	// a tier must not scale any spread to 100% or more
+	sr := SpreadRules{Default: 0.1, Currency: map[string]float64{"JPY": 0.25}, Tier: map[string]float64{"RETAIL": 4}}
+	if err := sr.Validate(); err == nil {
+		t.Fatal("expected an error for a scaled spread of 100%")
+	}
+
+	sr.Tier["RETAIL"] = 3.9
+	if err := sr.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQuoteSides(t *testing.T) {
+	tr := newTestRates(t)
+
+	err := tr.SetSpreads(SpreadRules{Default: 0.02})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := tr.GetRate("EUR", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Bid-r.Value*0.99) > 1e-9 || math.Abs(r.Ask-r.Value*1.01) > 1e-9 {
+		t.Fatalf("expected bid and ask 1%% around the mid rate, got %#v", r)
+	}
+
+	if r.Price(SideBid) != r.Bid || r.Price(SideAsk) != r.Ask || r.Price(SideMid) != r.Value {
+		t.Fatalf("unexpected prices for %#v", r)
+	}
+
+	m, _, err := tr.Convert(Money{100, 0}, "EUR", "USD", "", SideAsk, RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m != (Money{111, 100_000_000}) {
+		t.Fatalf("expected 111.10 USD at the ask rate, got %s", m)
+	}
+}
@@ -0,0 +1,154 @@
+package data
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultUnitDigits is the precision of units without a known or configured one
+const DefaultUnitDigits = 8
+
+// UnitSource provides the rates of units that are not ISO 4217 currencies,
+// such as cryptocurrencies or loyalty points. The rates are quoted against
+// Pivot, a currency of the rate store: 1 Pivot buys the rate in units
+type UnitSource struct {
+	Provider RateProvider
+	Pivot    string
+}
+
+// knownUnits describes common units that are not part of ISO 4217
+var knownUnits = map[string]CurrencyInfo{
+	"BTC":  {"BTC", "Bitcoin", 8, "₿"},
+	"ETH":  {"ETH", "Ether", 18, "Ξ"},
+	"LTC":  {"LTC", "Litecoin", 8, "Ł"},
+	"USDC": {"USDC", "USD Coin", 6, "USDC"},
+	"USDT": {"USDT", "Tether", 6, "₮"},
+}
+
+// unit is a unit of the rate store and the pivot it is quoted against
+type unit struct {
+	Info  CurrencyInfo
+	Pivot string
+}
+
+// unitLoad is a unit source of the rate store and the description of its units
+type unitLoad struct {
+	src  UnitSource
+	info map[string]CurrencyInfo
+}
+
+// AddUnits loads the units of the source into the rate store, info
+// overrides the description and precision of the units. The units are
+// reloaded with every refresh of the rates, they are not simulated and
+// follow the rate of their pivot in between
+func (e *ExchangeRates) AddUnits(src UnitSource, info map[string]CurrencyInfo) error {
+	err := e.loadUnits(src, info)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	old := e.unitLoads()
+	ls := make([]unitLoad, 0, len(old)+1)
+	ls = append(append(ls, old...), unitLoad{src, info})
+	e.units.Store(&ls)
+
+	return nil
+}
+
+// unitLoads returns the unit sources added to the rate store, the slice
+// must not be modified
+func (e *ExchangeRates) unitLoads() []unitLoad {
+	if ls := e.units.Load(); ls != nil {
+		return *ls
+	}
+
+	return nil
+}
+
+// refreshUnits reloads the units of every unit source, the units of a
+// source that fails keep their last quotes
+func (e *ExchangeRates) refreshUnits() {
+	for _, l := range e.unitLoads() {
+		err := e.loadUnits(l.src, l.info)
+		if err != nil {
+			e.log.Error("Unable to refresh units, serving the last rates", "provider", l.src.Provider.Name(), "error", err)
+		}
+	}
+}
+
+func (e *ExchangeRates) loadUnits(src UnitSource, info map[string]CurrencyInfo) error {
+	rates, err := src.Provider.Rates()
+	if err != nil {
+		return err
+	}
+
+	// a refresh that no longer quotes the pivot leaves its units without a
+	// rate until it is quoted again. Before the first load any ISO 4217
+	// currency is accepted as the pivot
+	rs := e.rates.Load()
+	_, iso := LookupCurrency(src.Pivot)
+	if _, ok := rs.graph[src.Pivot]; !ok && src.Pivot != rs.pivot && (len(rs.graph) > 0 || !iso) {
+		return fmt.Errorf("pivot %s of the %s units has no rate", src.Pivot, src.Provider.Name())
+	}
+
+	if _, ok := rs.units[src.Pivot]; ok {
+		return fmt.Errorf("pivot %s of the %s units cannot be a unit", src.Pivot, src.Provider.Name())
+	}
+
+	units := make(map[string]unit, len(rates))
+	quotes := make(map[string]float64, len(rates))
+	for k, v := range rates {
+		if _, ok := LookupCurrency(k); ok || k == src.Pivot {
+			return fmt.Errorf("unit %s of the %s units is an ISO 4217 currency", k, src.Provider.Name())
+		}
+
+		if v <= 0 {
+			return fmt.Errorf("rate of unit %s must be positive, got %f", k, v)
+		}
+
+		units[k] = unit{Info: unitInfo(k, info), Pivot: src.Pivot}
+		quotes[pairKey(src.Pivot, k)] = v
+	}
+
+	e.update(CauseUnits, func(rs *rateSet, now time.Time) {
+		for k, u := range units {
+			rs.units[k] = u
+		}
+
+		for k, v := range quotes {
+			rs.quotes[k] = v
+			rs.updated[k] = now
+			rs.sources[k] = src.Provider.Name()
+		}
+	})
+
+	e.log.Info("Loaded units", "provider", src.Provider.Name(), "pivot", src.Pivot, "units", len(units))
+
+	return nil
+}
+
+// unitInfo returns the description of a unit from info, the known units or
+// the defaults
+func unitInfo(code string, info map[string]CurrencyInfo) CurrencyInfo {
+	if ci, ok := info[code]; ok {
+		return ci
+	}
+
+	if ci, ok := knownUnits[code]; ok {
+		return ci
+	}
+
+	return CurrencyInfo{Code: code, Name: code, Digits: DefaultUnitDigits}
+}
+
+// Lookup returns the description of a currency or a unit of the rate store
+func (e *ExchangeRates) Lookup(code string) (CurrencyInfo, bool) {
+	if u, ok := e.rates.Load().units[code]; ok {
+		return u.Info, true
+	}
+
+	return LookupCurrency(code)
+}
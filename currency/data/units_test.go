@@ -0,0 +1,155 @@
+package data
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAddUnits(t *testing.T) {
+	tr := newTestRates(t)
+
+	err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0.00002}), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"PTS": 100}), "EUR"}, map[string]CurrencyInfo{"PTS": {"PTS", "Loyalty points", 0, ""}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1 BTC is 50000 USD
+	r, err := tr.GetRate("BTC", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-50000) > 1e-6 || r.Source != "fixed" {
+		t.Fatalf("expected 50000 USD per BTC from the fixed provider, got %#v", r)
+	}
+
+	// cross rates go through the pivot of the unit
+	r, err = tr.GetRate("BTC", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := 50000 / 1.1 * 160; math.Abs(r.Value-want) > 1e-6 {
+		t.Fatalf("expected %f JPY per BTC, got %f", want, r.Value)
+	}
+
+	ci, ok := tr.Lookup("BTC")
+	if !ok || ci.Digits != 8 || ci.Name != "Bitcoin" {
+		t.Fatalf("expected Bitcoin with 8 digits, got %#v", ci)
+	}
+
+	m, _, err := tr.Convert(Money{1, 0}, "USD", "BTC", "", SideMid, RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m != (Money{0, 20_000}) {
+		t.Fatalf("expected 0.00002000 BTC, got %s", m)
+	}
+
+	m, _, err = tr.Convert(Money{1, 500_000_000}, "EUR", "PTS", "", SideMid, RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m != (Money{150, 0}) {
+		t.Fatalf("expected 150 loyalty points, got %s", m)
+	}
+}
+
+func TestUnitsFollowPivot(t *testing.T) {
+	tr := newTestRates(t)
+
+	err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0.00002}), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr.update(CauseRefresh, func(rs *rateSet, _ time.Time) {
+		rs.quotes["EUR/USD"] = 1.2
+	})
+
+	r, err := tr.GetRate("BTC", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-50000) > 1e-6 {
+		t.Fatalf("expected the unit to follow its pivot, got %f USD per BTC", r.Value)
+	}
+}
+
+func TestRefreshUnits(t *testing.T) {
+	tr := newTestRates(t)
+
+	path := filepath.Join(t.TempDir(), "units.json")
+	err := os.WriteFile(path, []byte(`{"BTC": 0.00002}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tr.AddUnits(UnitSource{NewFileProvider(path), "USD"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the quote of the unit changes at the source
+	err = os.WriteFile(path, []byte(`{"BTC": 0.000025}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := tr.GetRate("BTC", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(r.Value-40000) > 1e-6 || r.Source != "file" {
+		t.Fatalf("expected 40000 USD per BTC after the refresh, got %#v", r)
+	}
+
+	// a failing source keeps the last quote of its units
+	err = os.Remove(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tr.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, _ = tr.GetRate("BTC", "USD")
+	if math.Abs(r.Value-40000) > 1e-6 {
+		t.Fatalf("expected the last quote of the unit, got %#v", r)
+	}
+}
+
+func TestAddUnitsInvalid(t *testing.T) {
+	tr := newTestRates(t)
+
+	if err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 1}), "CHF"}, nil); err == nil {
+		t.Fatal("expected an error for a pivot without a rate")
+	}
+
+	if err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"CAD": 1}), "USD"}, nil); err == nil {
+		t.Fatal("expected an error for an ISO currency loaded as a unit")
+	}
+
+	if err := tr.AddUnits(UnitSource{NewFixedProvider(map[string]float64{"BTC": 0}), "USD"}, nil); err == nil {
+		t.Fatal("expected an error for a zero unit rate")
+	}
+}
@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamRates bridges a rate subscription to Server-Sent Events, every
+// message of the subscription is sent as an ack, rate or error event until
+// the client disconnects
+func (g *Gateway) StreamRates(rw http.ResponseWriter, r *http.Request) {
+	rr, err := rateRequest(r)
+	if err != nil {
+		g.writeError(rw, err)
+		return
+	}
+
+	f, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// errors of the request are reported with a status code before the stream starts
+	_, err = g.cs.GetRate(r.Context(), rr)
+	if err != nil {
+		g.writeError(rw, err)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.WriteHeader(http.StatusOK)
+	f.Flush()
+
+	s := &eventStream{
+		ctx: r.Context(),
+		rw:  rw,
+		f:   f,
+		req: &protos.StreamingRateRequest{Message: &protos.StreamingRateRequest_Subscribe{Subscribe: rr}},
+	}
+
+	err = g.cs.SubscribeRates(s)
+	if err != nil {
+		g.log.Error("Rate event stream ended", "error", err)
+	}
+}
+
+// eventStream adapts an event stream to the subscription stream of the
+// Currency service. It receives a single subscription request, the
+// subscription ends when the client disconnects
+type eventStream struct {
+	// ServerStream is not used by the service, only Send, Recv and Context are
+	grpc.ServerStream
+
+	ctx  context.Context
+	rw   http.ResponseWriter
+	f    http.Flusher
+	req  *protos.StreamingRateRequest
+	sent bool
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) Recv() (*protos.StreamingRateRequest, error) {
+	if !s.sent {
+		s.sent = true
+		return s.req, nil
+	}
+
+	<-s.ctx.Done()
+	return nil, io.EOF
+}
+
+func (s *eventStream) Send(resp *protos.StreamingRateResponse) error {
+	var event string
+	var m proto.Message
+	switch msg := resp.Message.(type) {
+	case *protos.StreamingRateResponse_RateResponse:
+		event, m = "rate", msg.RateResponse
+	case *protos.StreamingRateResponse_Error:
+		event, m = "error", msg.Error
+	case *protos.StreamingRateResponse_Ack:
+		event, m = "ack", msg.Ack
+	default:
+		return nil
+	}
+
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(s.rw, "event: %s\ndata: %s\n\n", event, b)
+	if err != nil {
+		return err
+	}
+	s.f.Flush()
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package gateway
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/gorilla/mux"
+	hclog "github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Gateway serves the Currency service over HTTP with JSON bodies, it calls
+// the service in process
+type Gateway struct {
+	log hclog.Logger
+	cs  protos.CurrencyServer
+}
+
+func NewGateway(cs protos.CurrencyServer, l hclog.Logger) *Gateway {
+	return &Gateway{l, cs}
+}
+
+// Handler returns the routes of the gateway
+func (g *Gateway) Handler() http.Handler {
+	sm := mux.NewRouter()
+
+	getRouter := sm.Methods(http.MethodGet).Subrouter()
+	getRouter.HandleFunc("/rates/{base}/{dest}", g.GetRate)
+	getRouter.HandleFunc("/rates/{base}/{dest}/events", g.StreamRates)
+	getRouter.HandleFunc("/currencies", g.ListCurrencies)
+
+	return sm
+}
+
+// GetRate returns the rate of a pair, the side and tier query parameters
+// select the price
+func (g *Gateway) GetRate(rw http.ResponseWriter, r *http.Request) {
+	rr, err := rateRequest(r)
+	if err != nil {
+		g.writeError(rw, err)
+		return
+	}
+
+	resp, err := g.cs.GetRate(r.Context(), rr)
+	if err != nil {
+		g.writeError(rw, err)
+		return
+	}
+
+	g.writeJSON(rw, resp)
+}
+
+// ListCurrencies returns the currencies the service has rates for
+func (g *Gateway) ListCurrencies(rw http.ResponseWriter, r *http.Request) {
+	resp, err := g.cs.ListCurrencies(r.Context(), &protos.ListCurrenciesRequest{})
+	if err != nil {
+		g.writeError(rw, err)
+		return
+	}
+
+	g.writeJSON(rw, resp)
+}
+
+// rateRequest creates the rate request of the pair in the path, the query
+// may set the side, tier and the conditions of a subscription
+func rateRequest(r *http.Request) (*protos.RateRequest, error) {
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+
+	rr := &protos.RateRequest{
+		BaseCode:        vars["base"],
+		DestinationCode: vars["dest"],
+		Tier:            q.Get("tier"),
+	}
+
+	if s := q.Get("side"); s != "" {
+		v, ok := protos.Side_value["SIDE_"+strings.ToUpper(s)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown side %q, expected mid, bid or ask", s)
+		}
+		rr.Side = protos.Side(v)
+	}
+
+	c := &protos.SubscriptionConditions{}
+	for _, f := range []struct {
+		name string
+		v    *float64
+	}{
+		{"min_change", &c.MinChange},
+		{"upper", &c.Upper},
+		{"lower", &c.Lower},
+	} {
+		s := q.Get(f.name)
+		if s == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid %s %q", f.name, s)
+		}
+		*f.v = v
+	}
+
+	if s := q.Get("min_interval"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid min_interval %q", s)
+		}
+		c.MinInterval = durationpb.New(d)
+	}
+
+	if c.MinChange != 0 || c.Upper != 0 || c.Lower != 0 || c.MinInterval != nil {
+		rr.Conditions = c
+	}
+
+	return rr, nil
+}
+
+func (g *Gateway) writeJSON(rw http.ResponseWriter, m proto.Message) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		g.log.Error("Unable to encode response", "error", err)
+		http.Error(rw, "Unable to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(b)
+}
+
+// writeError writes the status of a gRPC error as JSON with the matching HTTP status code
+func (g *Gateway) writeError(rw http.ResponseWriter, err error) {
+	st := status.Convert(err)
+
+	b, merr := protojson.Marshal(st.Proto())
+	if merr != nil {
+		g.log.Error("Unable to encode error", "error", merr)
+		http.Error(rw, st.Message(), HTTPStatus(st.Code()))
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(HTTPStatus(st.Code()))
+	rw.Write(b)
+}
+
+// HTTPStatus maps a gRPC status code to an HTTP status code
+func HTTPStatus(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// the client closed the request, there is no standard code for it
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
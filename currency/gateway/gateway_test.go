@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/server"
+	"github.com/hashicorp/go-hclog"
+)
+
+func newTestGateway(t *testing.T, interval time.Duration) *httptest.Server {
+	log := hclog.NewNullLogger()
+
+	sim, err := data.NewSimulator(data.RandomWalk{Bound: 0.1}, 0.01, nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rates := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160}), "EUR", data.NewHistory(0), sim, nil)
+
+	cs := server.NewCurrency(rates, server.NewBroadcaster(64, server.DropOldest), interval, 0, log)
+	t.Cleanup(rates.Close)
+
+	ts := httptest.NewServer(NewGateway(cs, log).Handler())
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func TestGetRate(t *testing.T) {
+	ts := newTestGateway(t, time.Hour)
+
+	resp, err := http.Get(ts.URL + "/rates/EUR/USD?side=bid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		BaseCode        string
+		DestinationCode string
+		Rate            float64
+		Side            string
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+
+	if body.BaseCode != "EUR" || body.DestinationCode != "USD" || body.Rate != 1.1 || body.Side != "SIDE_BID" {
+		t.Fatalf("unexpected rate %+v", body)
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	ts := newTestGateway(t, time.Hour)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/rates/EUR/XYZ", http.StatusBadRequest},
+		{"/rates/EUR/EUR", http.StatusBadRequest},
+		{"/rates/EUR/USD?side=sideways", http.StatusBadRequest},
+		{"/rates/EUR/KES", http.StatusNotFound},
+		{"/unknown", http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			resp, err := http.Get(ts.URL + tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.status {
+				t.Fatalf("expected status %d, got %d", tc.status, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestListCurrencies(t *testing.T) {
+	ts := newTestGateway(t, time.Hour)
+
+	resp, err := http.Get(ts.URL + "/currencies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Currencies []struct{ Code string }
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(body.Currencies) != 3 {
+		t.Fatalf("expected 3 currencies, got %+v", body)
+	}
+}
+
+func TestStreamRates(t *testing.T) {
+	ts := newTestGateway(t, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/rates/EUR/USD/events", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("expected an event stream, got %s", ct)
+	}
+
+	var events []string
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() && len(events) < 2 {
+		if e, ok := strings.CutPrefix(sc.Text(), "event: "); ok {
+			events = append(events, e)
+		}
+	}
+
+	if len(events) != 2 || events[0] != "ack" || events[1] != "rate" {
+		t.Fatalf("expected an ack followed by a rate, got %v", events)
+	}
+}
@@ -3,7 +3,9 @@ module github.com/ellofae/gRPC-Bakery-Microservice/currency
 go 1.20
 
 require (
+	github.com/gorilla/mux v1.8.0
 	github.com/hashicorp/go-hclog v1.5.0
+	google.golang.org/genproto v0.0.0-20230306155012-7f2fa6fef1f4
 	google.golang.org/grpc v1.55.0
 	google.golang.org/protobuf v1.30.0
 )
@@ -16,5 +18,4 @@ require (
 	golang.org/x/net v0.8.0 // indirect
 	golang.org/x/sys v0.6.0 // indirect
 	golang.org/x/text v0.8.0 // indirect
-	google.golang.org/genproto v0.0.0-20230306155012-7f2fa6fef1f4 // indirect
 )
@@ -1,36 +1,188 @@
 package main
 
 import (
+	"errors"
 	"net"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/config"
 	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/gateway"
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/metrics"
 	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
 	"github.com/ellofae/gRPC-Bakery-Microservice/currency/server"
 	hclog "github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
 	log := hclog.Default()
 
-	rates, err := data.NewRates(log)
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		log.Error("Unable to load configuration", "error", err)
+		os.Exit(1)
+	}
+
+	reg := metrics.NewRegistry()
+
+	p, err := newProvider(cfg)
+	if err != nil {
+		log.Error("Unable to create rate provider", "error", err)
+		os.Exit(1)
+	}
+
+	fetches := reg.NewHistogram("currency_provider_request_seconds", "Latency of the requests to the rate providers.", metrics.DefaultBuckets, "provider", "result")
+	p.ObserveRequests(func(provider string, d time.Duration, err error) {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		fetches.Observe(d.Seconds(), provider, result)
+	})
+
+	sim, err := newSimulator(cfg)
+	if err != nil {
+		log.Error("Unable to create rate simulator", "error", err)
+		os.Exit(1)
+	}
+
+	rates, err := newRates(log, p, sim, cfg)
 	if err != nil {
 		log.Error("Unable to generate rates", "error", err)
 		os.Exit(1)
 	}
 
-	gs := grpc.NewServer()
-	cs := server.NewCurrency(rates, log)
+	for _, path := range cfg.HistorySeeds {
+		days, err := data.LoadECBHistory(path)
+		if err != nil {
+			log.Error("Unable to load rate history", "file", path, "error", err)
+			os.Exit(1)
+		}
+
+		log.Info("Seeded rate history", "file", path, "days", rates.SeedHistory(days))
+	}
+
+	if cfg.RefreshInterval > 0 {
+		rates.RefreshRates(cfg.RefreshInterval)
+	}
+
+	if cfg.Snapshot != "" {
+		rates.SnapshotRates(cfg.Snapshot, cfg.SnapshotInterval)
+	}
+
+	sr, err := newSpreadRules(cfg)
+	if err == nil {
+		err = rates.SetSpreads(sr)
+	}
+	if err != nil {
+		log.Error("Invalid spread rules", "error", err)
+		os.Exit(1)
+	}
+
+	err = addUnits(rates, cfg)
+	if err != nil {
+		log.Error("Unable to load units", "error", err)
+		os.Exit(1)
+	}
+
+	policy, err := server.ParseOverflowPolicy(cfg.OverflowPolicy)
+	if err != nil {
+		log.Error("Invalid overflow policy", "error", err)
+		os.Exit(1)
+	}
+
+	sm := metrics.NewServerMetrics(reg)
+	interceptors := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(sm.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(sm.StreamInterceptor()),
+	}
+
+	b := server.NewBroadcaster(cfg.QueueSize, policy)
+	gs := grpc.NewServer(interceptors...)
+	cs := server.NewCurrency(rates, b, cfg.UpdateInterval, cfg.MaxRateAge, log)
+	registerMetrics(reg, rates, cs)
 
 	protos.RegisterCurrencyServer(gs, cs)
 
+	hs := server.NewHealth(rates, cfg.HealthStaleAfter, log)
+	hs.Monitor(time.Second)
+	healthpb.RegisterHealthServer(gs, hs)
+
 	// Reflection API support setting
 	reflection.Register(gs)
 
+	if cfg.HTTPAddr != "" {
+		// no write timeout, event streams stay open
+		hs := &http.Server{
+			Addr:        cfg.HTTPAddr,
+			Handler:     gateway.NewGateway(cs, log).Handler(),
+			ReadTimeout: 5 * time.Second,
+			IdleTimeout: 120 * time.Second,
+		}
+
+		go func() {
+			log.Info("Starting HTTP gateway", "addr", cfg.HTTPAddr)
+
+			err := hs.ListenAndServe()
+			if err != nil {
+				log.Error("HTTP gateway stopped", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	if cfg.AdminAddr != "" {
+		// the admin service changes the rates, it is served apart from the public service
+		as := grpc.NewServer(interceptors...)
+		protos.RegisterAdminServer(as, server.NewAdmin(rates, log))
+
+		al, err := net.Listen("tcp", cfg.AdminAddr)
+		if err != nil {
+			log.Error("Unable to listen", "addr", cfg.AdminAddr, "error", err)
+			os.Exit(1)
+		}
+
+		go func() {
+			log.Info("Starting admin service", "addr", cfg.AdminAddr)
+
+			err := as.Serve(al)
+			if err != nil {
+				log.Error("Admin service stopped", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	if cfg.MetricsAddr != "" {
+		sm := http.NewServeMux()
+		sm.Handle("/metrics", reg)
+
+		ms := &http.Server{
+			Addr:         cfg.MetricsAddr,
+			Handler:      sm,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 10 * time.Second,
+		}
+
+		go func() {
+			log.Info("Starting metrics endpoint", "addr", cfg.MetricsAddr)
+
+			err := ms.ListenAndServe()
+			if err != nil {
+				log.Error("Metrics endpoint stopped", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// Specifing a port:
-	l, err := net.Listen("tcp", ":9092")
+	l, err := net.Listen("tcp", cfg.Addr)
 	if err != nil {
 		log.Error("Unable to listen", "error", err)
 		os.Exit(1)
@@ -38,3 +190,182 @@ func main() {
 
 	gs.Serve(l)
 }
+
+// newProvider creates the chain of the rate providers selected in the configuration
+func newProvider(cfg *config.Config) (*data.ProviderChain, error) {
+	ps := make([]data.RateProvider, 0, len(cfg.Providers))
+	for _, name := range cfg.Providers {
+		switch name {
+		case "file":
+			ps = append(ps, data.Rebase(data.NewFileProvider(cfg.RatesFile), cfg.ProviderBase))
+		case "fixed":
+			rates, err := data.ParseCurrencyValues(cfg.FixedRates)
+			if err != nil {
+				return nil, err
+			}
+			ps = append(ps, data.Rebase(data.NewFixedProvider(rates), cfg.ProviderBase))
+		default:
+			ps = append(ps, data.NewECBProvider(cfg.ECBURL, &http.Client{Timeout: cfg.ProviderTimeout}))
+		}
+	}
+
+	policy := data.ChainPolicy{
+		Timeout:   cfg.ProviderTimeout,
+		Retries:   cfg.ProviderRetries,
+		Backoff:   cfg.ProviderBackoff,
+		Threshold: cfg.BreakerThreshold,
+		Cooldown:  cfg.BreakerCooldown,
+	}
+
+	return data.NewProviderChain(policy, ps...), nil
+}
+
+// newRates creates the rate store from the provider, from the newest
+// snapshot or, in replay mode, from the audit log
+func newRates(log hclog.Logger, p data.RateProvider, sim *data.Simulator, cfg *config.Config) (*data.ExchangeRates, error) {
+	h := data.NewHistory(cfg.HistoryRetention)
+
+	var a *data.AuditLog
+	if cfg.AuditLog != "" {
+		// entries are read before the log is opened for the changes to come
+		var entries []data.AuditEntry
+		if cfg.Replay {
+			var err error
+			entries, err = data.ReadAuditLog(cfg.AuditLog)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		a, err = data.OpenAuditLog(cfg.AuditLog, cfg.AuditSync)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.Replay {
+			// the log is rotated with every snapshot, the entries are
+			// replayed on top of the newest one
+			var s *data.Snapshot
+			if cfg.Snapshot != "" {
+				s, err = data.LoadSnapshot(cfg.Snapshot)
+				if errors.Is(err, data.ErrNoSnapshot) {
+					s, err = nil, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return data.ReplayRates(log, p, cfg.Pivot, h, sim, a, s, entries)
+		}
+	}
+
+	if cfg.Snapshot != "" {
+		s, err := data.LoadSnapshot(cfg.Snapshot)
+		if err == nil {
+			var er *data.ExchangeRates
+			er, err = data.RestoreRates(log, p, cfg.Pivot, h, sim, a, s)
+			if err == nil {
+				return er, nil
+			}
+		}
+
+		if !errors.Is(err, data.ErrNoSnapshot) {
+			log.Warn("Unable to restore rates from the snapshot, loading them from the provider", "path", cfg.Snapshot, "error", err)
+		}
+	}
+
+	return data.NewRates(log, p, cfg.Pivot, h, sim, a), nil
+}
+
+// newSimulator creates the rate simulator, it returns nil when the simulation is off
+func newSimulator(cfg *config.Config) (*data.Simulator, error) {
+	if !cfg.Simulate {
+		return nil, nil
+	}
+
+	m, err := data.NewModel(cfg.SimModel, cfg.SimParam)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, err := data.ParseCurrencyValues(cfg.SimCurrencyVolatility)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.NewSimulator(m, cfg.SimVolatility, cv, cfg.SimSeed)
+}
+
+// newSpreadRules creates the spread rules from the configuration
+func newSpreadRules(cfg *config.Config) (data.SpreadRules, error) {
+	sr := data.SpreadRules{Default: cfg.Spread}
+
+	var err error
+	sr.Currency, err = data.ParseCurrencyValues(cfg.CurrencySpreads)
+	if err != nil {
+		return sr, err
+	}
+
+	sr.Pair, err = data.ParseCurrencyValues(cfg.PairSpreads)
+	if err != nil {
+		return sr, err
+	}
+
+	sr.Tier, err = data.ParseCurrencyValues(cfg.TierMultipliers)
+	if err != nil {
+		return sr, err
+	}
+
+	return sr, nil
+}
+
+// addUnits loads the non-ISO units of the configured unit sources
+func addUnits(rates *data.ExchangeRates, cfg *config.Config) error {
+	digits, err := data.ParseCurrencyValues(cfg.UnitDigits)
+	if err != nil {
+		return err
+	}
+
+	info := make(map[string]data.CurrencyInfo, len(digits))
+	for k, v := range digits {
+		info[k] = data.CurrencyInfo{Code: k, Name: k, Digits: int(v)}
+	}
+
+	for _, us := range cfg.UnitSources {
+		// the format is checked when the configuration is loaded
+		parts := strings.SplitN(us, ":", 3)
+
+		var p data.RateProvider = data.NewFileProvider(parts[2])
+		if parts[1] == "fixed" {
+			rates, err := data.ParseCurrencyValues(parts[2])
+			if err != nil {
+				return err
+			}
+			p = data.NewFixedProvider(rates)
+		}
+
+		err := rates.AddUnits(data.UnitSource{Provider: p, Pivot: strings.ToUpper(parts[0])}, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerMetrics exposes the state of the subscriptions and the age of the rates
+func registerMetrics(reg *metrics.Registry, rates *data.ExchangeRates, cs *server.Currency) {
+	cs.RegisterMetrics(reg)
+
+	reg.NewGaugeVecFunc("currency_rate_age_seconds", "Time since the rate of a currency last changed.", "currency", func() map[string]float64 {
+		now := time.Now()
+
+		ages := map[string]float64{}
+		for _, c := range rates.Currencies() {
+			ages[c.Code] = now.Sub(c.Updated).Seconds()
+		}
+		return ages
+	})
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ServerMetrics records the requests of a gRPC server by method: the
+// requests started, the requests handled by status code and their latency.
+// The latency of a stream is its whole lifetime
+type ServerMetrics struct {
+	started *Counter
+	handled *Counter
+	latency *Histogram
+}
+
+func NewServerMetrics(r *Registry) *ServerMetrics {
+	return &ServerMetrics{
+		started: r.NewCounter("grpc_server_started_total", "Requests started on the server.", "grpc_method", "grpc_type"),
+		handled: r.NewCounter("grpc_server_handled_total", "Requests completed on the server by status code.", "grpc_method", "grpc_type", "grpc_code"),
+		latency: r.NewHistogram("grpc_server_handling_seconds", "Latency of the requests handled by the server.", DefaultBuckets, "grpc_method", "grpc_type"),
+	}
+}
+
+// UnaryInterceptor records the unary requests
+func (m *ServerMetrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := m.start(info.FullMethod, "unary")
+		resp, err := handler(ctx, req)
+		m.done(info.FullMethod, "unary", start, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor records the streams
+func (m *ServerMetrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		typ := streamType(info)
+
+		start := m.start(info.FullMethod, typ)
+		err := handler(srv, ss)
+		m.done(info.FullMethod, typ, start, err)
+
+		return err
+	}
+}
+
+func (m *ServerMetrics) start(method, typ string) time.Time {
+	m.started.Inc(method, typ)
+	return time.Now()
+}
+
+func (m *ServerMetrics) done(method, typ string, start time.Time, err error) {
+	m.handled.Inc(method, typ, status.Code(err).String())
+	m.latency.Observe(time.Since(start).Seconds(), method, typ)
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return "bidi_stream"
+	case info.IsClientStream:
+		return "client_stream"
+	}
+
+	return "server_stream"
+}
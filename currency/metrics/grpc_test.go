@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestServerMetrics(t *testing.T) {
+	r := NewRegistry()
+	sm := NewServerMetrics(r)
+
+	l := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer(grpc.UnaryInterceptor(sm.UnaryInterceptor()), grpc.StreamInterceptor(sm.StreamInterceptor()))
+	healthpb.RegisterHealthServer(gs, health.NewServer())
+	go gs.Serve(l)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	hc := healthpb.NewHealthClient(conn)
+
+	_, err = hc.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an unknown service is not found
+	hc.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "Unknown"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w, err := hc.Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = w.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	want := []string{
+		`grpc_server_started_total{grpc_method="/grpc.health.v1.Health/Check",grpc_type="unary"} 2`,
+		`grpc_server_handled_total{grpc_method="/grpc.health.v1.Health/Check",grpc_type="unary",grpc_code="NotFound"} 1`,
+		`grpc_server_handled_total{grpc_method="/grpc.health.v1.Health/Check",grpc_type="unary",grpc_code="OK"} 1`,
+		`grpc_server_handling_seconds_count{grpc_method="/grpc.health.v1.Health/Check",grpc_type="unary"} 2`,
+		`grpc_server_handled_total{grpc_method="/grpc.health.v1.Health/Watch",grpc_type="server_stream",grpc_code="Canceled"} 1`,
+	}
+
+	// the stream ends on the server after the client cancelled it
+	deadline := time.Now().Add(time.Second)
+	for {
+		sb := &strings.Builder{}
+		r.Write(sb)
+
+		missing := ""
+		for _, s := range want {
+			if !strings.Contains(sb.String(), s) {
+				missing = s
+			}
+		}
+
+		if missing == "" {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %s in\n%s", missing, sb.String())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
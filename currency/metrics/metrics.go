@@ -0,0 +1,313 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the upper bounds in seconds of the latency histograms
+var DefaultBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// metric is a metric family of the registry
+type metric interface {
+	write(w io.Writer)
+}
+
+// Registry holds the metrics of the service and serves them in the
+// Prometheus text format, it is safe for concurrent use
+type Registry struct {
+	mu      sync.Mutex
+	metrics []metric
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(m metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.metrics = append(r.metrics, m)
+}
+
+// Write writes every metric in the order they were created
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	ms := append([]metric(nil), r.metrics...)
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, m := range ms {
+		m.write(bw)
+	}
+
+	return bw.Flush()
+}
+
+// ServeHTTP serves the metrics to a Prometheus scrape
+func (r *Registry) ServeHTTP(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	r.Write(rw)
+}
+
+// desc is the name, help and label names of a metric family
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+func (d desc) header(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", d.name, escapeHelp(d.help), d.name, d.typ)
+}
+
+// sample writes a sample of the family, the values are paired with the label
+// names followed by the extra label and value if set
+func (d desc) sample(w io.Writer, suffix string, values []string, extra, extraValue string, v float64) {
+	fmt.Fprint(w, d.name, suffix)
+
+	n := len(values)
+	if extra != "" {
+		n++
+	}
+
+	if n > 0 {
+		fmt.Fprint(w, "{")
+		for i, l := range d.labels {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", l, escapeLabel(values[i]))
+		}
+
+		if extra != "" {
+			if len(values) > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", extra, escapeLabel(extraValue))
+		}
+		fmt.Fprint(w, "}")
+	}
+
+	fmt.Fprintf(w, " %s\n", formatFloat(v))
+}
+
+// key identifies the series of a family by its label values
+func (d desc) key(values []string) string {
+	if len(values) != len(d.labels) {
+		panic(fmt.Sprintf("metric %s has labels %v, got values %v", d.name, d.labels, values))
+	}
+
+	return strings.Join(values, "\xff")
+}
+
+// Counter is a family of counters partitioned by label values
+type Counter struct {
+	desc
+
+	mu     sync.Mutex
+	series map[string]*counterSeries
+}
+
+type counterSeries struct {
+	values []string
+	v      float64
+}
+
+// NewCounter creates a counter, the label values are passed to Add in the
+// order of the label names
+func (r *Registry) NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{desc: desc{name, help, "counter", labels}, series: map[string]*counterSeries{}}
+	r.register(c)
+
+	return c
+}
+
+// Inc adds 1 to the counter of the label values
+func (c *Counter) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add adds v to the counter of the label values, v must not be negative
+func (c *Counter) Add(v float64, values ...string) {
+	k := c.key(values)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.series[k]
+	if !ok {
+		s = &counterSeries{values: append([]string(nil), values...)}
+		c.series[k] = s
+	}
+	s.v += v
+}
+
+func (c *Counter) write(w io.Writer) {
+	c.header(w)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, k := range sortedKeys(c.series) {
+		s := c.series[k]
+		c.sample(w, "", s.values, "", "", s.v)
+	}
+}
+
+// Histogram is a family of histograms partitioned by label values
+type Histogram struct {
+	desc
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	values []string
+	// counts holds the observations per bucket, not cumulated
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewHistogram creates a histogram with the given bucket upper bounds, the
+// label values are passed to Observe in the order of the label names
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+
+	h := &Histogram{desc: desc{name, help, "histogram", labels}, buckets: b, series: map[string]*histogramSeries{}}
+	r.register(h)
+
+	return h
+}
+
+// Observe records v in the histogram of the label values
+func (h *Histogram) Observe(v float64, values ...string) {
+	k := h.key(values)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.series[k]
+	if !ok {
+		s = &histogramSeries{values: append([]string(nil), values...), counts: make([]uint64, len(h.buckets))}
+		h.series[k] = s
+	}
+
+	if i := sort.SearchFloat64s(h.buckets, v); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += v
+}
+
+func (h *Histogram) write(w io.Writer) {
+	h.header(w)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, k := range sortedKeys(h.series) {
+		s := h.series[k]
+
+		var cum uint64
+		for i, b := range h.buckets {
+			cum += s.counts[i]
+			h.sample(w, "_bucket", s.values, "le", formatFloat(b), float64(cum))
+		}
+		h.sample(w, "_bucket", s.values, "le", "+Inf", float64(s.count))
+		h.sample(w, "_sum", s.values, "", "", s.sum)
+		h.sample(w, "_count", s.values, "", "", float64(s.count))
+	}
+}
+
+// funcMetric reads its samples when the metrics are written, it holds a
+// single label at most
+type funcMetric struct {
+	desc
+	fn func() map[string]float64
+}
+
+// NewCounterFunc creates a counter whose value is read from fn, for counts
+// kept elsewhere
+func (r *Registry) NewCounterFunc(name, help string, fn func() float64) {
+	r.register(&funcMetric{desc{name, help, "counter", nil}, func() map[string]float64 { return map[string]float64{"": fn()} }})
+}
+
+// NewGaugeFunc creates a gauge whose value is read from fn
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(&funcMetric{desc{name, help, "gauge", nil}, func() map[string]float64 { return map[string]float64{"": fn()} }})
+}
+
+// NewGaugeVecFunc creates a gauge with a single label, fn returns the
+// value by label value
+func (r *Registry) NewGaugeVecFunc(name, help, label string, fn func() map[string]float64) {
+	r.register(&funcMetric{desc{name, help, "gauge", []string{label}}, fn})
+}
+
+// NewCounterVecFunc creates a counter with a single label, fn returns the
+// count by label value
+func (r *Registry) NewCounterVecFunc(name, help, label string, fn func() map[string]float64) {
+	r.register(&funcMetric{desc{name, help, "counter", []string{label}}, fn})
+}
+
+func (m *funcMetric) write(w io.Writer) {
+	m.header(w)
+
+	vs := m.fn()
+	for _, k := range sortedKeys(vs) {
+		var values []string
+		if len(m.labels) > 0 {
+			values = []string{k}
+		}
+		m.sample(w, "", values, "", "", vs[k])
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	r := NewRegistry()
+
+	c := r.NewCounter("requests_total", "Requests by method.", "method", "code")
+	c.Inc("/Currency/GetRate", "OK")
+	c.Add(2, "/Currency/GetRate", "OK")
+	c.Inc("/Currency/Convert", "InvalidArgument")
+
+	h := r.NewHistogram("latency_seconds", "Latency.", []float64{1, 0.1}, "method")
+	h.Observe(0.05, "get")
+	h.Observe(0.5, "get")
+	h.Observe(5, "get")
+
+	r.NewGaugeFunc("subscribers", "Connected subscribers.", func() float64 { return 3 })
+	r.NewGaugeVecFunc("rate_age_seconds", "Rate age.", "currency", func() map[string]float64 {
+		return map[string]float64{"USD": 1.5, "EUR": 0}
+	})
+
+	sb := &strings.Builder{}
+	err := r.Write(sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `# HELP requests_total Requests by method.
+# TYPE requests_total counter
+requests_total{method="/Currency/Convert",code="InvalidArgument"} 1
+requests_total{method="/Currency/GetRate",code="OK"} 3
+# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{method="get",le="0.1"} 1
+latency_seconds_bucket{method="get",le="1"} 2
+latency_seconds_bucket{method="get",le="+Inf"} 3
+latency_seconds_sum{method="get"} 5.55
+latency_seconds_count{method="get"} 3
+# HELP subscribers Connected subscribers.
+# TYPE subscribers gauge
+subscribers 3
+# HELP rate_age_seconds Rate age.
+# TYPE rate_age_seconds gauge
+rate_age_seconds{currency="EUR"} 0
+rate_age_seconds{currency="USD"} 1.5
+`
+	if sb.String() != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, sb.String())
+	}
+}
+
+func TestRegistryEscaping(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("errors_total", "Errors\nby \\ message.", "message").Inc("a \"quoted\"\nline")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Fatalf("expected the Prometheus text format, got %s", ct)
+	}
+
+	body := rec.Body.String()
+	for _, s := range []string{
+		`# HELP errors_total Errors\nby \\ message.`,
+		`errors_total{message="a \"quoted\"\nline"} 1`,
+	} {
+		if !strings.Contains(body, s) {
+			t.Errorf("expected %s in\n%s", s, body)
+		}
+	}
+}
+
+func TestCounterLabels(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for missing label values")
+		}
+	}()
+
+	NewRegistry().NewCounter("requests_total", "Requests.", "method").Inc()
+}
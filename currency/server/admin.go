@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	hclog "github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Admin changes the rates of the rate store by hand
+type Admin struct {
+	log   hclog.Logger
+	rates *data.ExchangeRates
+}
+
+func NewAdmin(r *data.ExchangeRates, log hclog.Logger) *Admin {
+	return &Admin{log, r}
+}
+
+// SetRateOverride pins the rate of a pair until it is cleared or its TTL elapses
+func (a *Admin) SetRateOverride(ctx context.Context, sr *protos.SetRateOverrideRequest) (*protos.RateOverride, error) {
+	a.log.Info("Handle SetRateOverride", "base_code", sr.GetBaseCode(), "destination_code", sr.GetDestinationCode(), "rate", sr.GetRate())
+
+	base, dest, err := adminPair(sr, sr.BaseCode, sr.DestinationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(sr.Rate > 0) || math.IsInf(sr.Rate, 1) {
+		return nil, newStatusError(codes.InvalidArgument, sr, "Rate must be positive and finite, got %f", sr.Rate)
+	}
+
+	var ttl time.Duration
+	if sr.TTL != nil {
+		if err := sr.TTL.CheckValid(); err != nil || sr.TTL.AsDuration() < 0 {
+			return nil, newStatusError(codes.InvalidArgument, sr, "TTL must be a positive duration")
+		}
+		ttl = sr.TTL.AsDuration()
+	}
+
+	o, err := a.rates.SetOverride(base, dest, sr.Rate, ttl)
+	if errors.Is(err, data.ErrRateNotFound) {
+		return nil, newStatusError(codes.NotFound, sr, "Unable to override %s to %s: %s", base, dest, err)
+	}
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, sr, "Unable to override %s to %s: %s", base, dest, err)
+	}
+
+	return overrideResponse(o), nil
+}
+
+// ClearRateOverride removes the override of a pair in either direction
+func (a *Admin) ClearRateOverride(ctx context.Context, cr *protos.ClearRateOverrideRequest) (*protos.RateOverride, error) {
+	a.log.Info("Handle ClearRateOverride", "base_code", cr.GetBaseCode(), "destination_code", cr.GetDestinationCode())
+
+	base, dest, err := adminPair(cr, cr.BaseCode, cr.DestinationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := a.rates.ClearOverride(base, dest)
+	if errors.Is(err, data.ErrNoOverride) {
+		return nil, newStatusError(codes.NotFound, cr, "No override for %s to %s", base, dest)
+	}
+	if err != nil {
+		return nil, newStatusError(codes.Internal, cr, "Unable to clear the override of %s to %s", base, dest)
+	}
+
+	return overrideResponse(o), nil
+}
+
+// ListRateOverrides returns the overrides in effect
+func (a *Admin) ListRateOverrides(ctx context.Context, lr *protos.ListRateOverridesRequest) (*protos.ListRateOverridesResponse, error) {
+	a.log.Info("Handle ListRateOverrides")
+
+	resp := &protos.ListRateOverridesResponse{}
+	for _, o := range a.rates.Overrides() {
+		resp.Overrides = append(resp.Overrides, overrideResponse(o))
+	}
+
+	return resp, nil
+}
+
+// FreezeRates stops or resumes the simulated changes of the rates
+func (a *Admin) FreezeRates(ctx context.Context, fr *protos.FreezeRatesRequest) (*protos.FreezeRatesResponse, error) {
+	a.log.Info("Handle FreezeRates", "frozen", fr.GetFrozen())
+
+	a.rates.Freeze(fr.Frozen)
+
+	return &protos.FreezeRatesResponse{Frozen: a.rates.Frozen()}, nil
+}
+
+// ForceRefresh reloads the rates from the provider
+func (a *Admin) ForceRefresh(ctx context.Context, fr *protos.ForceRefreshRequest) (*protos.ForceRefreshResponse, error) {
+	a.log.Info("Handle ForceRefresh")
+
+	err := a.rates.Refresh()
+	if err != nil {
+		return nil, newStatusError(codes.Unavailable, fr, "Unable to refresh the rates: %s", err)
+	}
+
+	st := a.rates.RefreshStatus()
+
+	return &protos.ForceRefreshResponse{
+		Provider:   st.Provider,
+		Currencies: int32(len(a.rates.Currencies())),
+		Time:       timestamppb.New(st.Time),
+	}, nil
+}
+
+// GetAuditLog returns the recorded changes of the quotes, the entries prove
+// which quotes a rate of a past sequence was made of
+func (a *Admin) GetAuditLog(ctx context.Context, ar *protos.AuditLogRequest) (*protos.AuditLogResponse, error) {
+	a.log.Info("Handle GetAuditLog", "codes", ar.GetCodes())
+
+	cs := make([]string, len(ar.Codes))
+	for i, c := range ar.Codes {
+		cs[i] = strings.ToUpper(strings.TrimSpace(c))
+	}
+
+	var start, end time.Time
+	if ar.Start != nil {
+		start = ar.Start.AsTime()
+	}
+	if ar.End != nil {
+		end = ar.End.AsTime()
+	}
+
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, newStatusError(codes.InvalidArgument, ar, "End %s is before start %s", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+
+	entries, err := a.rates.AuditEntries(cs, start, end)
+	if errors.Is(err, data.ErrNoAuditLog) {
+		return nil, newStatusError(codes.FailedPrecondition, ar, "The audit log is disabled")
+	}
+	if err != nil {
+		return nil, newStatusError(codes.Internal, ar, "Unable to read the audit log: %s", err)
+	}
+
+	resp := &protos.AuditLogResponse{Entries: make([]*protos.AuditEntry, len(entries))}
+	for i, e := range entries {
+		resp.Entries[i] = &protos.AuditEntry{
+			Time:     timestamppb.New(e.Time),
+			Sequence: e.Sequence,
+			Pair:     e.Pair,
+			Old:      e.Old,
+			New:      e.New,
+			Cause:    e.Cause,
+			Source:   e.Source,
+		}
+
+		if e.Expires != nil {
+			resp.Entries[i].Expires = timestamppb.New(*e.Expires)
+		}
+	}
+
+	return resp, nil
+}
+
+// adminPair normalises the codes of an admin request, they must be set and differ
+func adminPair(req protoiface.MessageV1, baseCode, destCode string) (string, string, error) {
+	base := strings.ToUpper(strings.TrimSpace(baseCode))
+	dest := strings.ToUpper(strings.TrimSpace(destCode))
+
+	if base == "" || dest == "" {
+		return "", "", newStatusError(codes.InvalidArgument, req, "BaseCode and DestinationCode are required")
+	}
+
+	if base == dest {
+		return "", "", newStatusError(codes.InvalidArgument, req, "Base currency %s cannot be the same as the destination currency %s", base, dest)
+	}
+
+	return base, dest, nil
+}
+
+func overrideResponse(o data.Override) *protos.RateOverride {
+	resp := &protos.RateOverride{
+		BaseCode:        o.Base,
+		DestinationCode: o.Dest,
+		Rate:            o.Rate,
+		Set:             timestamppb.New(o.Set),
+	}
+
+	if !o.Expires.IsZero() {
+		resp.Expires = timestamppb.New(o.Expires)
+	}
+
+	return resp
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestAdminOverride(t *testing.T) {
+	cs, client := newTestServer(t, time.Hour)
+	a := NewAdmin(cs.rates, hclog.NewNullLogger())
+
+	o, err := a.SetRateOverride(context.Background(), &protos.SetRateOverrideRequest{BaseCode: "eur", DestinationCode: "usd", Rate: 2, TTL: durationpb.New(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.BaseCode != "EUR" || o.Expires == nil {
+		t.Fatalf("expected an expiring EUR/USD override, got %v", o)
+	}
+
+	resp, err := client.GetRate(context.Background(), &protos.RateRequest{BaseCode: "EUR", DestinationCode: "USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Rate != 2 || resp.Source != "override" {
+		t.Fatalf("expected the override rate, got %v", resp)
+	}
+
+	_, err = a.ClearRateOverride(context.Background(), &protos.ClearRateOverrideRequest{BaseCode: "USD", DestinationCode: "EUR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		req  *protos.SetRateOverrideRequest
+		code codes.Code
+	}{
+		{&protos.SetRateOverrideRequest{BaseCode: "EUR", DestinationCode: "USD"}, codes.InvalidArgument},
+		{&protos.SetRateOverrideRequest{BaseCode: "EUR", DestinationCode: "EUR", Rate: 1}, codes.InvalidArgument},
+		{&protos.SetRateOverrideRequest{BaseCode: "EUR", DestinationCode: "USD", Rate: math.NaN()}, codes.InvalidArgument},
+		{&protos.SetRateOverrideRequest{BaseCode: "EUR", DestinationCode: "USD", Rate: math.Inf(1)}, codes.InvalidArgument},
+		{&protos.SetRateOverrideRequest{BaseCode: "EUR", DestinationCode: "USD", Rate: 1, TTL: durationpb.New(-time.Second)}, codes.InvalidArgument},
+		{&protos.SetRateOverrideRequest{BaseCode: "EUR", DestinationCode: "KES", Rate: 1}, codes.NotFound},
+	} {
+		_, err := a.SetRateOverride(context.Background(), tc.req)
+		if status.Code(err) != tc.code {
+			t.Errorf("expected %s for %v, got %v", tc.code, tc.req, err)
+		}
+	}
+
+	_, err = a.ClearRateOverride(context.Background(), &protos.ClearRateOverrideRequest{BaseCode: "EUR", DestinationCode: "USD"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound without an override, got %v", err)
+	}
+}
+
+func TestAdminFreezeAndRefresh(t *testing.T) {
+	cs, _ := newTestServer(t, time.Hour)
+	a := NewAdmin(cs.rates, hclog.NewNullLogger())
+
+	fr, err := a.FreezeRates(context.Background(), &protos.FreezeRatesRequest{Frozen: true})
+	if err != nil || !fr.Frozen || !cs.rates.Frozen() {
+		t.Fatalf("expected frozen rates, got %v, %v", fr, err)
+	}
+
+	rr, err := a.ForceRefresh(context.Background(), &protos.ForceRefreshRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rr.Provider != "fixed" || rr.Currencies != 4 {
+		t.Fatalf("expected 4 currencies from the fixed provider, got %v", rr)
+	}
+}
+
+func TestAdminAuditLogDisabled(t *testing.T) {
+	cs, _ := newTestServer(t, time.Hour)
+	a := NewAdmin(cs.rates, hclog.NewNullLogger())
+
+	_, err := a.GetAuditLog(context.Background(), &protos.AuditLogRequest{Codes: []string{"USD"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition without an audit log, got %v", err)
+	}
+}
@@ -0,0 +1,318 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OverflowPolicy decides what happens to a rate update when the queue
+// of a subscriber is full
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest queued rate update
+	DropOldest OverflowPolicy = iota
+	// CoalesceLatest replaces the queued update for the same currency pair
+	// with the latest rate, or discards the oldest update if there is none
+	CoalesceLatest
+	// DisconnectSlow closes the stream of the subscriber
+	DisconnectSlow
+)
+
+// ParseOverflowPolicy parses drop-oldest, coalesce or disconnect
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	switch s {
+	case "drop-oldest":
+		return DropOldest, nil
+	case "coalesce":
+		return CoalesceLatest, nil
+	case "disconnect":
+		return DisconnectSlow, nil
+	}
+
+	return 0, fmt.Errorf("unknown overflow policy %q", s)
+}
+
+// errSlowConsumer ends the stream of a subscriber that could not keep up
+var errSlowConsumer = status.Error(codes.ResourceExhausted, "Subscriber could not keep up with rate updates")
+
+// SubscriberStats reports how far a subscriber lags behind the updates
+type SubscriberStats struct {
+	// ID identifies the subscriber while it is connected
+	ID            string
+	Subscriptions int
+	// Queued is the number of messages waiting to be sent
+	Queued  int
+	Sent    uint64
+	Dropped uint64
+	// LastLag is the time the last sent message spent in the queue
+	LastLag time.Duration
+	MaxLag  time.Duration
+}
+
+// BroadcastStats counts the messages sent to every subscriber of a
+// broadcaster, including the subscribers that are gone
+type BroadcastStats struct {
+	Sent uint64
+	// Failed counts the messages the stream failed to send
+	Failed uint64
+}
+
+// Broadcaster creates subscribers that send through bounded queues, so a
+// slow client never holds up the updates of the others
+type Broadcaster struct {
+	queueSize int
+	policy    OverflowPolicy
+	// subscribers numbers the subscribers for their ID
+	subscribers atomic.Uint64
+	sent        atomic.Uint64
+	failed      atomic.Uint64
+}
+
+// NewBroadcaster creates a broadcaster, queueSize bounds the number of
+// messages waiting for each subscriber, replies included
+func NewBroadcaster(queueSize int, policy OverflowPolicy) *Broadcaster {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+
+	return &Broadcaster{queueSize: queueSize, policy: policy}
+}
+
+// Stats returns the messages sent to the subscribers so far
+func (b *Broadcaster) Stats() BroadcastStats {
+	return BroadcastStats{b.sent.Load(), b.failed.Load()}
+}
+
+func (b *Broadcaster) newSubscriber(stream protos.Currency_SubscribeRatesServer) *subscriber {
+	return &subscriber{
+		id:     strconv.FormatUint(b.subscribers.Add(1), 10),
+		b:      b,
+		stream: stream,
+		size:   b.queueSize,
+		policy: b.policy,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+}
+
+// queued is a message waiting in the queue of a subscriber
+type queued struct {
+	resp *protos.StreamingRateResponse
+	// rate updates can be dropped, replies to requests are always sent
+	rate bool
+	at   time.Time
+}
+
+// queue holds the messages waiting to be sent to a subscriber
+type queue struct {
+	mu      sync.Mutex
+	items   []queued
+	closing bool
+	stopped bool
+	err     error
+	stats   SubscriberStats
+}
+
+// Send queues a reply to a request of the subscriber, replies are never
+// dropped: a full queue makes room by dropping the oldest rate update, and
+// the subscriber is disconnected when there is none or the policy says so
+func (s *subscriber) Send(resp *protos.StreamingRateResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return s.err
+	}
+
+	if len(s.items) >= s.size && (s.policy == DisconnectSlow || !s.dropOldest()) {
+		s.stop(errSlowConsumer)
+		return s.err
+	}
+
+	s.items = append(s.items, queued{resp: resp, at: time.Now()})
+	s.wake()
+
+	return nil
+}
+
+// Publish queues a rate update applying the overflow policy when the
+// queue is full, it never blocks and reports whether an update was dropped
+func (s *subscriber) Publish(resp *protos.StreamingRateResponse) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return false
+	}
+
+	item := queued{resp: resp, rate: true, at: time.Now()}
+
+	dropped := false
+	if len(s.items) >= s.size {
+		switch s.policy {
+		case DisconnectSlow:
+			s.stats.Dropped++
+			s.stop(errSlowConsumer)
+			return true
+		case CoalesceLatest:
+			if i := s.findPair(resp.GetRateResponse()); i >= 0 {
+				// keep the place in the queue but send the latest rate
+				item.at = s.items[i].at
+				s.items[i] = item
+				s.stats.Dropped++
+				return true
+			}
+		}
+
+		if !s.dropOldest() {
+			// the queue is full of replies, the update is dropped instead
+			s.stats.Dropped++
+			return true
+		}
+		dropped = true
+	}
+
+	s.items = append(s.items, item)
+	s.wake()
+
+	return dropped
+}
+
+// findPair returns the position of the queued update for the same currency pair
+func (s *subscriber) findPair(rr *protos.RateResponse) int {
+	for i, q := range s.items {
+		if !q.rate {
+			continue
+		}
+
+		// the enum fields are zero for codes outside the enum, the codes identify the pair
+		qr := q.resp.GetRateResponse()
+		if qr.GetBaseCode() == rr.GetBaseCode() && qr.GetDestinationCode() == rr.GetDestinationCode() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// dropOldest drops the oldest queued rate update, it reports false when
+// only replies are queued
+func (s *subscriber) dropOldest() bool {
+	for i, q := range s.items {
+		if q.rate {
+			s.items = append(s.items[:i], s.items[i+1:]...)
+			s.stats.Dropped++
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *subscriber) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// stop ends the delivery to the subscriber, s.mu must be held
+func (s *subscriber) stop(err error) {
+	if s.stopped {
+		return
+	}
+
+	s.stopped = true
+	s.err = err
+	s.items = nil
+	close(s.done)
+}
+
+// Close stops the delivery after the queued messages have been sent
+func (s *subscriber) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closing = true
+	s.wake()
+}
+
+// Abort stops the delivery at once and discards the queued messages
+func (s *subscriber) Abort(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stop(err)
+}
+
+// Stats returns the delivery statistics of the subscriber
+func (s *subscriber) Stats() SubscriberStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stats
+	st.ID = s.id
+	st.Queued = len(s.items)
+	st.Subscriptions = len(s.Subscriptions())
+
+	return st
+}
+
+// run sends the queued messages on the stream until the subscriber is closed
+// or stopped, it is the only goroutine that sends on the stream
+func (s *subscriber) run() error {
+	for {
+		s.mu.Lock()
+		if s.stopped {
+			err := s.err
+			s.mu.Unlock()
+			return err
+		}
+
+		if len(s.items) == 0 {
+			closing := s.closing
+			s.mu.Unlock()
+
+			if closing {
+				return nil
+			}
+
+			select {
+			case <-s.notify:
+			case <-s.done:
+			}
+			continue
+		}
+
+		item := s.items[0]
+		s.items = s.items[1:]
+		s.mu.Unlock()
+
+		err := s.stream.Send(item.resp)
+
+		s.mu.Lock()
+		if err != nil {
+			s.b.failed.Add(1)
+			s.stop(err)
+			s.mu.Unlock()
+			return err
+		}
+
+		s.b.sent.Add(1)
+		lag := time.Since(item.at)
+		s.stats.Sent++
+		s.stats.LastLag = lag
+		if lag > s.stats.MaxLag {
+			s.stats.MaxLag = lag
+		}
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,181 @@
+package server
+
+import (
+	"errors"
+	"testing"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+)
+
+func rateUpdate(dest protos.Currencies, rate float64) *protos.StreamingRateResponse {
+	return codeUpdate("EUR", dest.String(), rate)
+}
+
+// codeUpdate is the update of a pair by code, the enum fields are set as
+// the service does for codes outside the enum
+func codeUpdate(base, dest string, rate float64) *protos.StreamingRateResponse {
+	return &protos.StreamingRateResponse{
+		Message: &protos.StreamingRateResponse_RateResponse{
+			RateResponse: &protos.RateResponse{
+				Base:            currencyEnum(base),
+				Destination:     currencyEnum(dest),
+				BaseCode:        base,
+				DestinationCode: dest,
+				Rate:            rate,
+			},
+		},
+	}
+}
+
+func queuedRates(s *subscriber) []float64 {
+	rates := []float64{}
+	for _, q := range s.items {
+		if q.rate {
+			rates = append(rates, q.resp.GetRateResponse().GetRate())
+		}
+	}
+
+	return rates
+}
+
+func TestPublishDropOldest(t *testing.T) {
+	s := NewBroadcaster(3, DropOldest).newSubscriber(nil)
+
+	s.Publish(rateUpdate(protos.Currencies_USD, 1))
+	s.Publish(rateUpdate(protos.Currencies_GBP, 2))
+	s.Send(ackResponse(protos.SubscriptionAck_LIST_SUBSCRIPTIONS, nil, nil))
+
+	if !s.Publish(rateUpdate(protos.Currencies_USD, 3)) {
+		t.Fatal("expected an update to be dropped")
+	}
+
+	got := queuedRates(s)
+	if len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Fatalf("expected the oldest update to be dropped, got %v", got)
+	}
+
+	if len(s.items) != 3 || s.Stats().Dropped != 1 {
+		t.Fatalf("expected the reply to be kept, got %d queued", len(s.items))
+	}
+}
+
+func TestSendFullQueue(t *testing.T) {
+	s := NewBroadcaster(2, DropOldest).newSubscriber(nil)
+
+	s.Publish(rateUpdate(protos.Currencies_USD, 1))
+	ack := ackResponse(protos.SubscriptionAck_LIST_SUBSCRIPTIONS, nil, nil)
+
+	// the reply takes the place of the rate update
+	if err := s.Send(ack); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(ack); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.items) != 2 || len(queuedRates(s)) != 0 || s.Stats().Dropped != 1 {
+		t.Fatalf("expected 2 queued replies, got %d messages", len(s.items))
+	}
+
+	// rate updates cannot make room in a queue full of replies
+	if !s.Publish(rateUpdate(protos.Currencies_USD, 2)) || len(s.items) != 2 {
+		t.Fatalf("expected the update to be dropped, got %d messages", len(s.items))
+	}
+
+	if err := s.Send(ack); err != errSlowConsumer {
+		t.Fatalf("expected errSlowConsumer, got %v", err)
+	}
+}
+
+func TestSendFullQueueDisconnect(t *testing.T) {
+	s := NewBroadcaster(1, DisconnectSlow).newSubscriber(nil)
+
+	s.Publish(rateUpdate(protos.Currencies_USD, 1))
+
+	if err := s.Send(ackResponse(protos.SubscriptionAck_LIST_SUBSCRIPTIONS, nil, nil)); err != errSlowConsumer {
+		t.Fatalf("expected errSlowConsumer, got %v", err)
+	}
+}
+
+func TestPublishCoalesceLatest(t *testing.T) {
+	s := NewBroadcaster(2, CoalesceLatest).newSubscriber(nil)
+
+	s.Publish(rateUpdate(protos.Currencies_USD, 1))
+	s.Publish(rateUpdate(protos.Currencies_GBP, 2))
+	s.Publish(rateUpdate(protos.Currencies_GBP, 3))
+
+	got := queuedRates(s)
+	if len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Fatalf("expected the GBP update to be replaced, got %v", got)
+	}
+}
+
+func TestPublishCoalesceCodes(t *testing.T) {
+	s := NewBroadcaster(2, CoalesceLatest).newSubscriber(nil)
+
+	// the units are outside the enum, the pairs differ by code only
+	s.Publish(codeUpdate("BTC", "PTS", 1))
+	s.Publish(codeUpdate("ETH", "PTS", 2))
+	s.Publish(codeUpdate("BTC", "PTS", 3))
+
+	got := queuedRates(s)
+	if len(got) != 2 || got[0] != 3 || got[1] != 2 {
+		t.Fatalf("expected the BTC/PTS update to be replaced, got %v", got)
+	}
+
+	s.Publish(codeUpdate("ETH", "LTC", 4))
+
+	got = queuedRates(s)
+	if len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Fatalf("expected the oldest update to be dropped for a new pair, got %v", got)
+	}
+}
+
+func TestPublishDisconnectSlow(t *testing.T) {
+	s := NewBroadcaster(1, DisconnectSlow).newSubscriber(nil)
+
+	s.Publish(rateUpdate(protos.Currencies_USD, 1))
+	s.Publish(rateUpdate(protos.Currencies_USD, 2))
+
+	select {
+	case <-s.done:
+	default:
+		t.Fatal("expected the subscriber to be stopped")
+	}
+
+	if err := s.run(); err != errSlowConsumer {
+		t.Fatalf("expected errSlowConsumer, got %v", err)
+	}
+}
+
+// failingStream fails every send after the first ok sends
+type failingStream struct {
+	protos.Currency_SubscribeRatesServer
+	ok int
+}
+
+func (s *failingStream) Send(*protos.StreamingRateResponse) error {
+	if s.ok == 0 {
+		return errors.New("broken pipe")
+	}
+	s.ok--
+
+	return nil
+}
+
+func TestBroadcastStats(t *testing.T) {
+	b := NewBroadcaster(8, DropOldest)
+	s := b.newSubscriber(&failingStream{ok: 2})
+
+	for i := 0; i < 3; i++ {
+		s.Publish(rateUpdate(protos.Currencies_USD, float64(i)))
+	}
+
+	if err := s.run(); err == nil {
+		t.Fatal("expected the failed send to stop the subscriber")
+	}
+
+	if st := b.Stats(); st.Sent != 2 || st.Failed != 1 {
+		t.Fatalf("expected 2 sent and 1 failed message, got %#v", st)
+	}
+}
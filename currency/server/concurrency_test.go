@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+)
+
+// TestConcurrentClients runs GetRate calls and subscription changes while rates
+// are updated every millisecond, run it with -race to detect data races
+func TestConcurrentClients(t *testing.T) {
+	_, cc := newTestServer(t, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dests := []protos.Currencies{protos.Currencies_USD, protos.Currencies_JPY, protos.Currencies_GBP}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 200; j++ {
+				_, err := cc.GetRate(ctx, &protos.RateRequest{Base: protos.Currencies_EUR, Destination: dests[j%len(dests)]})
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sub, err := cc.SubscribeRates(ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer sub.CloseSend()
+
+			for j := 0; j < 50; j++ {
+				d := dests[j%len(dests)]
+
+				for _, req := range []*protos.StreamingRateRequest{
+					subscribeRequest(protos.Currencies_EUR, d),
+					{Message: &protos.StreamingRateRequest_ListSubscriptions{}},
+					unsubscribeRequest(protos.Currencies_EUR, d),
+				} {
+					err := sub.Send(req)
+					if err != nil {
+						errs <- err
+						return
+					}
+
+					// skip rate updates until the operation is acknowledged
+					for {
+						resp, err := sub.Recv()
+						if err != nil {
+							errs <- err
+							return
+						}
+
+						if resp.GetRateResponse() == nil {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+)
+
+// subscription is a subscribed currency pair, it keeps track of the pushed
+// rates to evaluate the conditions of the request
+type subscription struct {
+	req *protos.RateRequest
+	// base and dest are the ISO codes of the subscribed pair
+	base, dest string
+	// side is the side of the rate the conditions apply to
+	side data.Side
+
+	mu sync.Mutex
+	// last is the last pushed rate or the rate at the time of subscribing
+	last     float64
+	lastTime time.Time
+	// seen is the rate of the previous update, used to detect crossed bounds
+	seen float64
+	// crossedUpper and crossedLower keep a crossing of the bounds that
+	// happened while the updates were throttled until it is pushed
+	crossedUpper, crossedLower bool
+	// stale is set while the rate is too old to be pushed
+	stale bool
+}
+
+func newSubscription(rr *protos.RateRequest, base, dest string, side data.Side, rate float64) *subscription {
+	return &subscription{req: rr, base: base, dest: dest, side: side, last: rate, seen: rate}
+}
+
+// validateConditions checks the conditions of a subscription request
+func validateConditions(c *protos.SubscriptionConditions) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.MinChange < 0 {
+		return fmt.Errorf("minimum change %f cannot be negative", c.MinChange)
+	}
+
+	if c.Upper < 0 || c.Lower < 0 {
+		return fmt.Errorf("bounds cannot be negative")
+	}
+
+	if c.Upper > 0 && c.Lower > 0 && c.Lower > c.Upper {
+		return fmt.Errorf("lower bound %f is above the upper bound %f", c.Lower, c.Upper)
+	}
+
+	if c.MinInterval != nil {
+		if err := c.MinInterval.CheckValid(); err != nil {
+			return err
+		}
+
+		if c.MinInterval.AsDuration() < 0 {
+			return fmt.Errorf("minimum interval cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+// Notify reports whether the updated rate has to be pushed to the subscriber
+func (s *subscription) Notify(rate float64, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.req.GetConditions()
+	if c == nil {
+		s.push(rate, now)
+		return true
+	}
+
+	// bounds trigger when they are crossed, not on every update past them.
+	// A crossing holds while the rate stays past the bound
+	if c.Upper > 0 {
+		s.crossedUpper = rate > c.Upper && (s.seen <= c.Upper || s.crossedUpper)
+	}
+
+	if c.Lower > 0 {
+		s.crossedLower = rate < c.Lower && (s.seen >= c.Lower || s.crossedLower)
+	}
+
+	s.seen = rate
+
+	if mi := c.GetMinInterval().AsDuration(); mi > 0 && !s.lastTime.IsZero() && now.Sub(s.lastTime) < mi {
+		return false
+	}
+
+	triggers := c.MinChange > 0 || c.Upper > 0 || c.Lower > 0
+	if !triggers {
+		// only the interval is set, rates are throttled
+		s.push(rate, now)
+		return true
+	}
+
+	met := false
+	if c.MinChange > 0 && s.last != 0 && math.Abs(rate-s.last)/s.last >= c.MinChange {
+		met = true
+	}
+
+	if s.crossedUpper || s.crossedLower {
+		met = true
+	}
+
+	if met {
+		s.push(rate, now)
+	}
+
+	return met
+}
+
+// SetStale records whether the rate is stale and reports if that changed
+func (s *subscription) SetStale(stale bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := s.stale != stale
+	s.stale = stale
+
+	return changed
+}
+
+// push records a pushed rate, s.mu must be held
+func (s *subscription) push(rate float64, now time.Time) {
+	s.last = rate
+	s.lastTime = now
+	s.crossedUpper = false
+	s.crossedLower = false
+}
+
+// requests returns the subscription requests for an acknowledgement
+func requests(subs []*subscription) []*protos.RateRequest {
+	rrs := make([]*protos.RateRequest, len(subs))
+	for i, s := range subs {
+		rrs[i] = s.req
+	}
+
+	return rrs
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSubscriptionNotify(t *testing.T) {
+	t0 := time.Date(2023, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		cond  *protos.SubscriptionConditions
+		rates []float64
+		want  []bool
+	}{
+		{"no conditions", nil, []float64{1, 1, 1.001}, []bool{true, true, true}},
+		{"min change", &protos.SubscriptionConditions{MinChange: 0.01}, []float64{1.005, 1.011, 1.015, 1.0}, []bool{false, true, false, true}},
+		{"upper bound crossed once", &protos.SubscriptionConditions{Upper: 1.1}, []float64{1.05, 1.12, 1.15, 1.09, 1.11}, []bool{false, true, false, false, true}},
+		{"lower bound", &protos.SubscriptionConditions{Lower: 0.9}, []float64{0.95, 0.89, 0.85}, []bool{false, true, false}},
+		{"interval throttles", &protos.SubscriptionConditions{MinInterval: durationpb.New(90 * time.Second)}, []float64{1, 1, 1, 1}, []bool{true, false, true, false}},
+		{"interval limits changes", &protos.SubscriptionConditions{MinChange: 0.01, MinInterval: durationpb.New(90 * time.Second)}, []float64{1.02, 1.04, 1.06}, []bool{true, false, true}},
+		{"bound crossed while throttled", &protos.SubscriptionConditions{Upper: 1.1, Lower: 0.9, MinInterval: durationpb.New(90 * time.Second)}, []float64{0.89, 1.12, 1.13, 1.14}, []bool{true, false, true, false}},
+		{"bound crossed back while throttled", &protos.SubscriptionConditions{Upper: 1.1, Lower: 0.9, MinInterval: durationpb.New(150 * time.Second)}, []float64{0.89, 1.12, 1.05, 1.06}, []bool{true, false, false, false}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSubscription(&protos.RateRequest{Conditions: tc.cond}, "EUR", "USD", data.SideMid, 1)
+
+			for i, r := range tc.rates {
+				// one update per minute
+				got := s.Notify(r, t0.Add(time.Duration(i)*time.Minute))
+				if got != tc.want[i] {
+					t.Fatalf("update %d with rate %f: expected %t, got %t", i, r, tc.want[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConditions(t *testing.T) {
+	invalid := []*protos.SubscriptionConditions{
+		{MinChange: -0.1},
+		{Lower: 1.2, Upper: 1.1},
+		{MinInterval: durationpb.New(-time.Second)},
+	}
+
+	for _, c := range invalid {
+		if validateConditions(c) == nil {
+			t.Fatalf("expected %v to be invalid", c)
+		}
+	}
+
+	if err := validateConditions(&protos.SubscriptionConditions{MinChange: 0.01, Lower: 1, Upper: 1.2}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"strings"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// ErrorReasonUnknownCurrency is the ErrorInfo reason for a code missing from the ISO 4217 table
+const ErrorReasonUnknownCurrency = "UNKNOWN_CURRENCY"
+
+// ErrorInfo reasons of the errors of the rate store
+const (
+	ErrorReasonUnknownTier    = "UNKNOWN_TIER"
+	ErrorReasonRateNotFound   = "RATE_NOT_FOUND"
+	ErrorReasonAmountOverflow = "AMOUNT_OVERFLOW"
+	ErrorReasonNoRateHistory  = "NO_RATE_HISTORY"
+)
+
+// errorDomain is the ErrorInfo domain of the errors of the service
+const errorDomain = "currency"
+
+// newReasonStatus creates a status with an ErrorInfo giving the reason of
+// the error and the original request attached
+func newReasonStatus(code codes.Code, req protoiface.MessageV1, reason string, md map[string]string, format string, a ...interface{}) *status.Status {
+	st := status.Newf(code, format, a...)
+
+	wd, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: md}, req)
+	if err != nil {
+		return st
+	}
+
+	return wd
+}
+
+// currencyCode returns the code of a currency field of a request, a code
+// takes priority over the enum. Codes must be ISO 4217 currencies or units
+// of the rate store, unknown codes get an InvalidArgument status with an
+// ErrorInfo naming the field
+func (c *Currency) currencyCode(req protoiface.MessageV1, field string, cur protos.Currencies, code string) (string, *status.Status) {
+	if code == "" {
+		return cur.String(), nil
+	}
+
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if _, ok := c.rates.Lookup(code); ok {
+		return code, nil
+	}
+
+	md := map[string]string{"field": field, "code": code}
+	return "", newReasonStatus(codes.InvalidArgument, req, ErrorReasonUnknownCurrency, md, "Unknown currency code %q in %s", code, field)
+}
+
+// currencyPair resolves the base and destination of a request, they must differ
+func (c *Currency) currencyPair(req protoiface.MessageV1, base protos.Currencies, baseCode string, dest protos.Currencies, destCode string) (string, string, *status.Status) {
+	b, st := c.currencyCode(req, "BaseCode", base, baseCode)
+	if st != nil {
+		return "", "", st
+	}
+
+	d, st := c.currencyCode(req, "DestinationCode", dest, destCode)
+	if st != nil {
+		return "", "", st
+	}
+
+	if b == d {
+		return "", "", newStatus(codes.InvalidArgument, req, "Base currency %s cannot be the same as the destination currency %s", b, d)
+	}
+
+	return b, d, nil
+}
+
+// currencyEnum returns the enum value of a code for the enum fields of the
+// responses. Codes missing from the enum such as units map to the zero
+// value EUR, the enum fields are only meaningful for currencies of the enum
+// and the code fields are always set
+func currencyEnum(code string) protos.Currencies {
+	return protos.Currencies(protos.Currencies_value[code])
+}
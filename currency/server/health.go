@@ -0,0 +1,76 @@
+package server
+
+import (
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	hclog "github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// currencyService is the name of the Currency service in health checks
+const currencyService = "Currency"
+
+// Health is the grpc.health.v1 service of the currency server, the status
+// follows the rates: NOT_SERVING until the rates are loaded from the
+// provider, a snapshot or the audit log, and while the last load is older
+// than staleAfter
+type Health struct {
+	*health.Server
+
+	log   hclog.Logger
+	rates *data.ExchangeRates
+	// staleAfter is the age of the last load after which the rates are
+	// stale, 0 never considers them stale
+	staleAfter time.Duration
+	status     healthpb.HealthCheckResponse_ServingStatus
+}
+
+// NewHealth creates the health service, the status is set by Monitor
+func NewHealth(r *data.ExchangeRates, staleAfter time.Duration, log hclog.Logger) *Health {
+	h := &Health{health.NewServer(), log, r, staleAfter, healthpb.HealthCheckResponse_UNKNOWN}
+	h.update(time.Now())
+
+	return h
+}
+
+// Monitor checks the rates every interval and updates the status, Watch
+// streams are sent every change
+func (h *Health) Monitor(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		for now := range ticker.C {
+			h.update(now)
+		}
+	}()
+}
+
+// Status returns the status of the service at the given time. Frozen rates
+// are held on purpose and never stale
+func (h *Health) Status(now time.Time) healthpb.HealthCheckResponse_ServingStatus {
+	loaded := h.rates.RefreshStatus().Loaded()
+	if loaded.IsZero() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	if h.staleAfter > 0 && now.Sub(loaded) > h.staleAfter && !h.rates.Frozen() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+// update sets the status of the server and of the Currency service
+func (h *Health) update(now time.Time) {
+	s := h.Status(now)
+	if s == h.status {
+		return
+	}
+
+	h.log.Info("Health status changed", "from", h.status, "to", s, "updated", h.rates.RefreshStatus().Loaded())
+	h.status = s
+
+	h.SetServingStatus("", s)
+	h.SetServingStatus(currencyService, s)
+}
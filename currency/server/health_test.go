@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestHealth(t *testing.T) {
+	log := hclog.NewNullLogger()
+
+	path := filepath.Join(t.TempDir(), "rates.json")
+
+	// nothing is served while the provider is down and there is no snapshot
+	empty := data.NewRates(log, data.NewFileProvider(path), "EUR", data.NewHistory(0), nil, nil)
+	if s := NewHealth(empty, time.Hour, log).Status(time.Now()); s != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING before the first load, got %s", s)
+	}
+
+	// the rates are restored from a snapshot while the provider is down
+	fixed := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1}), "EUR", data.NewHistory(0), nil, nil)
+	snap := fixed.Snapshot()
+
+	rates, err := data.RestoreRates(log, data.NewFileProvider(path), "EUR", data.NewHistory(0), nil, nil, snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHealth(rates, time.Hour, log)
+
+	l := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer()
+	healthpb.RegisterHealthServer(gs, h)
+	go gs.Serve(l)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	hc := healthpb.NewHealthClient(conn)
+
+	resp, err := hc.Check(context.Background(), &healthpb.HealthCheckRequest{Service: currencyService})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected the restored rates to be served, got %s", resp.Status)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	w, err := hc.Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := func(want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+
+		resp, err := w.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.Status != want {
+			t.Fatalf("expected %s, got %s", want, resp.Status)
+		}
+	}
+
+	expect(healthpb.HealthCheckResponse_SERVING)
+
+	// the restored rates go stale with the age of the snapshot
+	h.update(snap.Time.Add(2 * time.Hour))
+	expect(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	// the provider comes back
+	err = os.WriteFile(path, []byte(`{"USD": 1.2}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = rates.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	h.update(now)
+	expect(healthpb.HealthCheckResponse_SERVING)
+
+	// the rates go stale
+	h.update(now.Add(2 * time.Hour))
+	expect(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	// frozen rates are never stale
+	rates.Freeze(true)
+	h.update(now.Add(2 * time.Hour))
+	expect(healthpb.HealthCheckResponse_SERVING)
+}
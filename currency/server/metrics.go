@@ -0,0 +1,56 @@
+package server
+
+import (
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/metrics"
+)
+
+// RegisterMetrics exports the subscribers of the rate stream and their
+// delivery statistics, the series of a subscriber are labelled by its ID
+// and end when it disconnects
+func (c *Currency) RegisterMetrics(reg *metrics.Registry) {
+	reg.NewGaugeFunc("currency_subscribers", "Connected rate subscribers.", func() float64 {
+		return float64(len(c.SubscriberStats()))
+	})
+
+	reg.NewGaugeFunc("currency_subscriptions", "Active rate subscriptions.", func() float64 {
+		n := 0
+		for _, st := range c.SubscriberStats() {
+			n += st.Subscriptions
+		}
+		return float64(n)
+	})
+
+	reg.NewCounterFunc("currency_updates_sent_total", "Messages sent to rate subscribers.", func() float64 {
+		return float64(c.broadcaster.Stats().Sent)
+	})
+
+	reg.NewCounterFunc("currency_send_failures_total", "Messages that failed to send to rate subscribers.", func() float64 {
+		return float64(c.broadcaster.Stats().Failed)
+	})
+
+	reg.NewGaugeVecFunc("currency_subscriber_lag_seconds", "Time the last message sent to a subscriber spent in its queue.", "subscriber", func() map[string]float64 {
+		return c.subscriberStats(func(st SubscriberStats) float64 { return st.LastLag.Seconds() })
+	})
+
+	reg.NewGaugeVecFunc("currency_subscriber_max_lag_seconds", "Longest time a message sent to a subscriber spent in its queue.", "subscriber", func() map[string]float64 {
+		return c.subscriberStats(func(st SubscriberStats) float64 { return st.MaxLag.Seconds() })
+	})
+
+	reg.NewGaugeVecFunc("currency_subscriber_queued", "Messages waiting to be sent to a subscriber.", "subscriber", func() map[string]float64 {
+		return c.subscriberStats(func(st SubscriberStats) float64 { return float64(st.Queued) })
+	})
+
+	reg.NewCounterVecFunc("currency_subscriber_dropped_total", "Rate updates dropped for a subscriber that could not keep up.", "subscriber", func() map[string]float64 {
+		return c.subscriberStats(func(st SubscriberStats) float64 { return float64(st.Dropped) })
+	})
+}
+
+// subscriberStats returns a statistic of every connected subscriber by ID
+func (c *Currency) subscriberStats(fn func(SubscriberStats) float64) map[string]float64 {
+	vs := map[string]float64{}
+	for _, st := range c.SubscriberStats() {
+		vs[st.ID] = fn(st)
+	}
+
+	return vs
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/metrics"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestSubscriberMetrics(t *testing.T) {
+	log := hclog.NewNullLogger()
+	rates := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1}), "EUR", data.NewHistory(0), nil, nil)
+	cs := NewCurrency(rates, NewBroadcaster(1, DropOldest), time.Hour, 0, log)
+	t.Cleanup(rates.Close)
+
+	reg := metrics.NewRegistry()
+	cs.RegisterMetrics(reg)
+
+	s := cs.broadcaster.newSubscriber(&failingStream{ok: 8})
+	cs.subscriptions.Register(s)
+
+	s.Publish(rateUpdate(protos.Currencies_USD, 1))
+	s.Publish(rateUpdate(protos.Currencies_USD, 2))
+	s.Close()
+	if err := s.run(); err != nil {
+		t.Fatal(err)
+	}
+
+	sb := &strings.Builder{}
+	if err := reg.Write(sb); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range []string{
+		"# TYPE currency_subscriber_dropped_total counter",
+		`currency_subscriber_dropped_total{subscriber="1"} 1`,
+		"# TYPE currency_subscriber_lag_seconds gauge",
+		`currency_subscriber_lag_seconds{subscriber="1"} `,
+		`currency_subscriber_queued{subscriber="1"} 0`,
+		"currency_subscribers 1",
+		"currency_updates_sent_total 1",
+	} {
+		if !strings.Contains(sb.String(), m) {
+			t.Errorf("expected %s in\n%s", m, sb.String())
+		}
+	}
+
+	cs.subscriptions.Unregister(s.stream)
+
+	sb.Reset()
+	if err := reg.Write(sb); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(sb.String(), `subscriber="1"`) {
+		t.Fatalf("expected the series to end with the subscriber, got\n%s", sb.String())
+	}
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetProviderHealth returns the outcome of the refreshes of the rates and the
+// health of every provider of the chain
+func (c *Currency) GetProviderHealth(ctx context.Context, pr *protos.ProviderHealthRequest) (*protos.ProviderHealthResponse, error) {
+	c.log.Info("Handle GetProviderHealth")
+
+	st := c.rates.RefreshStatus()
+	resp := &protos.ProviderHealthResponse{
+		Provider:    st.Provider,
+		LastRefresh: timestamp(st.Time),
+		LastAttempt: timestamp(st.Attempted),
+	}
+
+	if st.Err != nil {
+		resp.LastError = st.Err.Error()
+	}
+
+	for _, h := range c.rates.ProviderHealth() {
+		resp.Providers = append(resp.Providers, &protos.ProviderHealth{
+			Name:                h.Name,
+			State:               protos.BreakerState(h.State),
+			ConsecutiveFailures: int32(h.ConsecutiveFailures),
+			Requests:            h.Requests,
+			Failures:            h.Failures,
+			LastSuccess:         timestamp(h.LastSuccess),
+			LastFailure:         timestamp(h.LastFailure),
+			LastError:           h.LastError,
+			OpenUntil:           timestamp(h.OpenUntil),
+		})
+	}
+
+	return resp, nil
+}
+
+// timestamp converts a time to a timestamp, the zero time is left unset
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
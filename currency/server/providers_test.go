@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestGetProviderHealth(t *testing.T) {
+	log := hclog.NewNullLogger()
+
+	chain := data.NewProviderChain(data.ChainPolicy{Threshold: 1, Cooldown: time.Hour},
+		data.NewFileProvider("missing.json"),
+		data.NewFixedProvider(map[string]float64{"USD": 1.1}),
+	)
+
+	rates := data.NewRates(log, chain, "EUR", data.NewHistory(0), nil, nil)
+
+	c := NewCurrency(rates, NewBroadcaster(8, DropOldest), time.Hour, 0, log)
+	t.Cleanup(rates.Close)
+
+	resp, err := c.GetProviderHealth(context.Background(), &protos.ProviderHealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Provider != "fixed" || resp.LastRefresh == nil || resp.LastError != "" {
+		t.Fatalf("expected a refresh from the fixed provider, got %v", resp)
+	}
+
+	if len(resp.Providers) != 2 {
+		t.Fatalf("expected 2 providers, got %v", resp.Providers)
+	}
+
+	file := resp.Providers[0]
+	if file.Name != "file" || file.State != protos.BreakerState_BREAKER_OPEN || file.OpenUntil == nil || file.LastError == "" {
+		t.Fatalf("expected the breaker of the file provider to be open, got %v", file)
+	}
+
+	if fixed := resp.Providers[1]; fixed.State != protos.BreakerState_BREAKER_CLOSED || fixed.LastSuccess == nil || fixed.LastFailure != nil {
+		t.Fatalf("expected a healthy fixed provider, got %v", fixed)
+	}
+}
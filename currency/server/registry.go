@@ -0,0 +1,144 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+)
+
+var (
+	errSubscriptionExists   = fmt.Errorf("subscription already exists")
+	errSubscriptionNotFound = fmt.Errorf("subscription does not exist")
+)
+
+// subscriber is a client of the SubscribeRates stream, messages to the
+// client go through its queue and are sent by run
+type subscriber struct {
+	id string
+	// b counts the messages sent to every subscriber
+	b      *Broadcaster
+	stream protos.Currency_SubscribeRatesServer
+	size   int
+	policy OverflowPolicy
+	notify chan struct{}
+	// done is closed when the delivery to the subscriber stops
+	done chan struct{}
+
+	queue
+
+	// subscriptions is replaced as a whole on every change
+	subscriptions atomic.Pointer[[]*subscription]
+}
+
+// Subscriptions returns the current subscriptions, the slice must not be modified
+func (s *subscriber) Subscriptions() []*subscription {
+	if rrs := s.subscriptions.Load(); rrs != nil {
+		return *rrs
+	}
+
+	return nil
+}
+
+// registry keeps the subscribers of the rate stream, it is safe for
+// concurrent use. Writers copy the set of subscribers and publish the
+// copy so readers never lock
+type registry struct {
+	mu          sync.Mutex
+	subscribers atomic.Pointer[map[protos.Currency_SubscribeRatesServer]*subscriber]
+}
+
+func newRegistry() *registry {
+	r := &registry{}
+	r.subscribers.Store(&map[protos.Currency_SubscribeRatesServer]*subscriber{})
+
+	return r
+}
+
+// Subscribers returns the current subscribers, the map must not be modified
+func (r *registry) Subscribers() map[protos.Currency_SubscribeRatesServer]*subscriber {
+	return *r.subscribers.Load()
+}
+
+// Register adds a subscriber without subscriptions
+func (r *registry) Register(s *subscriber) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.modify(func(m map[protos.Currency_SubscribeRatesServer]*subscriber) { m[s.stream] = s })
+}
+
+// Unregister removes the subscriber of the stream and returns
+// the number of subscriptions it had
+func (r *registry) Unregister(stream protos.Currency_SubscribeRatesServer) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.Subscribers()[stream]
+	if !ok {
+		return 0
+	}
+
+	r.modify(func(m map[protos.Currency_SubscribeRatesServer]*subscriber) { delete(m, stream) })
+
+	return len(s.Subscriptions())
+}
+
+// Subscribe adds a subscription and returns the resulting subscriptions
+func (r *registry) Subscribe(s *subscriber, sub *subscription) ([]*subscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rrs := s.Subscriptions()
+	if findSubscription(rrs, sub.base, sub.dest) >= 0 {
+		return nil, errSubscriptionExists
+	}
+
+	n := make([]*subscription, 0, len(rrs)+1)
+	n = append(append(n, rrs...), sub)
+	s.subscriptions.Store(&n)
+
+	return n, nil
+}
+
+// Unsubscribe removes a subscription and returns the remaining subscriptions
+func (r *registry) Unsubscribe(s *subscriber, base, dest string) ([]*subscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rrs := s.Subscriptions()
+	i := findSubscription(rrs, base, dest)
+	if i < 0 {
+		return nil, errSubscriptionNotFound
+	}
+
+	n := make([]*subscription, 0, len(rrs)-1)
+	n = append(append(n, rrs[:i]...), rrs[i+1:]...)
+	s.subscriptions.Store(&n)
+
+	return n, nil
+}
+
+// modify publishes a modified copy of the subscribers, r.mu must be held
+func (r *registry) modify(fn func(map[protos.Currency_SubscribeRatesServer]*subscriber)) {
+	old := r.Subscribers()
+
+	m := make(map[protos.Currency_SubscribeRatesServer]*subscriber, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+
+	fn(m)
+	r.subscribers.Store(&m)
+}
+
+func findSubscription(subs []*subscription, base, dest string) int {
+	for i, v := range subs {
+		if v.base == base && v.dest == dest {
+			return i
+		}
+	}
+
+	return -1
+}
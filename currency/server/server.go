@@ -2,7 +2,10 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
@@ -10,87 +13,526 @@ import (
 	hclog "github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Currency struct {
 	log           hclog.Logger
 	rates         *data.ExchangeRates
-	subscriptions map[protos.Currency_SubscribeRatesServer][]*protos.RateRequest
+	broadcaster   *Broadcaster
+	subscriptions *registry
+	// maxAge is the age after which a rate is too stale to use, 0 allows any age
+	maxAge time.Duration
 }
 
-func NewCurrency(r *data.ExchangeRates, log hclog.Logger) *Currency {
-	c := &Currency{log, r, make(map[protos.Currency_SubscribeRatesServer][]*protos.RateRequest)}
-	go c.handleUpdates()
+// NewCurrency creates the Currency service, subscribers receive updated rates every
+// interval. Rates older than maxAge are refused, a zero maxAge accepts rates of any age
+func NewCurrency(r *data.ExchangeRates, b *Broadcaster, interval, maxAge time.Duration, log hclog.Logger) *Currency {
+	c := &Currency{log, r, b, newRegistry(), maxAge}
+	go c.handleUpdates(interval)
 
 	return c
 }
 
-func (c *Currency) handleUpdates() {
-	ru := c.rates.MonitorRates(5 * time.Second)
+func (c *Currency) handleUpdates(interval time.Duration) {
+	ru := c.rates.MonitorRates(interval)
 	for range ru {
 		c.log.Info("Got updated rates")
+		now := time.Now()
 
 		// loop over subscribed clients
-		for k, v := range c.subscriptions {
+		for _, s := range c.subscriptions.Subscribers() {
 
 			// loop over rates
-			for _, rr := range v {
-				r, err := c.rates.GetRate(rr.GetBase().String(), rr.GetDestination().String())
+			for _, sub := range s.Subscriptions() {
+				rr := sub.req
+
+				r, err := c.rates.Quote(sub.base, sub.dest, rr.GetTier())
 				if err != nil {
-					c.log.Error("Unable to get updated rate", "base", rr.GetBase().String(), "destination", rr.GetDestination().String())
+					c.log.Error("Unable to get updated rate", "base", sub.base, "destination", sub.dest)
+					continue
+				}
+
+				// a stale rate is not pushed, the subscriber is told once that it went stale
+				if st := c.checkAge(rr, r, now); st != nil {
+					if sub.SetStale(true) {
+						s.Send(errorResponse(st))
+					}
+					continue
 				}
+				sub.SetStale(false)
 
-				err = k.Send(
+				// only push the rates that meet the conditions of the subscription
+				if !sub.Notify(r.Price(sub.side), now) {
+					continue
+				}
+
+				// queue the update, a slow subscriber does not hold up the others
+				dropped := s.Publish(
 					&protos.StreamingRateResponse{
 						Message: &protos.StreamingRateResponse_RateResponse{
-							RateResponse: &protos.RateResponse{Base: rr.Base, Destination: rr.Destination, Rate: r},
+							RateResponse: rateResponse(sub.base, sub.dest, rr.Side, r),
 						},
 					},
 				)
 
-				if err != nil {
-					c.log.Error("Unable to send updated rates", "base", rr.GetBase().String(), "destination", rr.GetDestination().String())
+				if dropped {
+					st := s.Stats()
+					c.log.Warn("Subscriber is lagging behind, dropped rate update", "base", sub.base, "destination", sub.dest, "queued", st.Queued, "dropped", st.Dropped)
 				}
 			}
 		}
 	}
 }
 
+// SubscriberStats returns the delivery statistics of every connected subscriber
+func (c *Currency) SubscriberStats() []SubscriberStats {
+	subs := c.subscriptions.Subscribers()
+
+	stats := make([]SubscriberStats, 0, len(subs))
+	for _, s := range subs {
+		stats = append(stats, s.Stats())
+	}
+
+	return stats
+}
+
 func (c *Currency) GetRate(ctx context.Context, rr *protos.RateRequest) (*protos.RateResponse, error) {
-	c.log.Info("Handle GetRate", "base", rr.GetBase(), "destination", rr.GetDestination())
-
-	if rr.Base == rr.Destination {
-		err := status.Newf(
-			codes.InvalidArgument,
-			"Base currency %s cannot be the same as the destination currency %s",
-			rr.Base.String(),
-			rr.Destination.String(),
-		)
-
-		err, wde := err.WithDetails(rr)
-		if wde != nil {
-			return nil, wde
+	c.log.Info("Handle GetRate", "base", rr.GetBase(), "destination", rr.GetDestination(), "base_code", rr.GetBaseCode(), "destination_code", rr.GetDestinationCode())
+
+	base, dest, st := c.currencyPair(rr, rr.Base, rr.BaseCode, rr.Destination, rr.DestinationCode)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	if _, err := toSide(rr.Side); err != nil {
+		return nil, newStatusError(codes.InvalidArgument, rr, "%s", err)
+	}
+
+	rate, err := c.rates.Quote(base, dest, rr.GetTier())
+	if errors.Is(err, data.ErrUnknownTier) {
+		md := map[string]string{"tier": rr.GetTier()}
+		return nil, newReasonStatus(codes.InvalidArgument, rr, ErrorReasonUnknownTier, md, "Unknown customer tier %s", rr.GetTier()).Err()
+	}
+	if err != nil {
+		md := map[string]string{"base": base, "destination": dest}
+		return nil, newReasonStatus(codes.NotFound, rr, ErrorReasonRateNotFound, md, "Rate not found for %s to %s", base, dest).Err()
+	}
+
+	if st := c.checkAge(rr, rate, time.Now()); st != nil {
+		return nil, st.Err()
+	}
+
+	return rateResponse(base, dest, rr.Side, rate), nil
+}
+
+// toSide maps the side of a request to the side of the rate store
+func toSide(s protos.Side) (data.Side, error) {
+	switch s {
+	case protos.Side_SIDE_MID:
+		return data.SideMid, nil
+	case protos.Side_SIDE_BID:
+		return data.SideBid, nil
+	case protos.Side_SIDE_ASK:
+		return data.SideAsk, nil
+	}
+
+	return 0, fmt.Errorf("unknown side %s", s.String())
+}
+
+// checkAge returns a FailedPrecondition status when the rate is older than the maximum age
+func (c *Currency) checkAge(req protoiface.MessageV1, r data.Rate, now time.Time) *status.Status {
+	if c.maxAge <= 0 || r.Age(now) <= c.maxAge {
+		return nil
+	}
+
+	return newStatus(
+		codes.FailedPrecondition,
+		req,
+		"Rate is too stale to use, last changed %s ago at %s, maximum age is %s",
+		r.Age(now).Round(time.Second),
+		r.AsOf.Format(time.RFC3339),
+		c.maxAge,
+	)
+}
+
+// rateResponse creates the response for a rate, Rate holds the price of the
+// requested side, the side must be valid
+func rateResponse(base, dest string, side protos.Side, r data.Rate) *protos.RateResponse {
+	ds, _ := toSide(side)
+
+	return &protos.RateResponse{
+		Base:            currencyEnum(base),
+		Destination:     currencyEnum(dest),
+		BaseCode:        base,
+		DestinationCode: dest,
+		Rate:            r.Price(ds),
+		AsOf:            timestamppb.New(r.AsOf),
+		Source:          r.Source,
+		Sequence:        r.Sequence,
+		Side:            side,
+		Bid:             r.Bid,
+		Ask:             r.Ask,
+		Mid:             r.Value,
+		Path:            r.Path,
+	}
+}
+
+func (c *Currency) GetRates(ctx context.Context, rr *protos.RatesRequest) (*protos.RatesResponse, error) {
+	c.log.Info("Handle GetRates", "base", rr.GetBase(), "base_code", rr.GetBaseCode(), "destinations", len(rr.GetDestinations())+len(rr.GetDestinationCodes()))
+
+	base, st := c.currencyCode(rr, "BaseCode", rr.Base, rr.BaseCode)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	// codes take priority over the enum destinations
+	dests := make([]string, len(rr.Destinations))
+	for i, d := range rr.Destinations {
+		dests[i] = d.String()
+	}
+
+	if len(rr.DestinationCodes) > 0 {
+		dests = make([]string, len(rr.DestinationCodes))
+		for i, d := range rr.DestinationCodes {
+			if strings.TrimSpace(d) == "" {
+				return nil, newStatusError(codes.InvalidArgument, rr, "Destination code %d is empty", i)
+			}
+
+			dests[i], st = c.currencyCode(rr, "DestinationCodes", 0, d)
+			if st != nil {
+				return nil, st.Err()
+			}
 		}
+	}
 
-		return nil, err.Err()
+	if len(dests) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, rr, "At least one destination currency must be specified")
 	}
 
-	rate, err := c.rates.GetRate(rr.GetBase().String(), rr.GetDestination().String())
+	side, err := toSide(rr.Side)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, rr, "%s", err)
+	}
+
+	res, err := c.rates.GetRates(base, dests, rr.GetTier())
+	if errors.Is(err, data.ErrUnknownTier) {
+		md := map[string]string{"tier": rr.GetTier()}
+		return nil, newReasonStatus(codes.InvalidArgument, rr, ErrorReasonUnknownTier, md, "Unknown customer tier %s", rr.GetTier()).Err()
+	}
+	if err != nil {
+		md := map[string]string{"base": base}
+		return nil, newReasonStatus(codes.NotFound, rr, ErrorReasonRateNotFound, md, "Rate not found for base currency %s", base).Err()
+	}
+
+	now := time.Now()
+	resp := &protos.RatesResponse{Base: currencyEnum(base), BaseCode: base}
+	for _, r := range res {
+		d := r.Destination
+
+		var st *status.Status
+		switch {
+		case d == base:
+			st = status.Newf(codes.InvalidArgument, "Base currency %s cannot be the same as the destination currency %s", base, d)
+		case r.Err != nil:
+			st = status.Newf(codes.NotFound, "Rate not found for currency %s", d)
+		default:
+			st = c.checkAge(&protos.RateRequest{BaseCode: base, DestinationCode: d}, r.Rate, now)
+		}
+
+		result := &protos.RateResult{Destination: currencyEnum(d), DestinationCode: d}
+		if st != nil {
+			result.Result = &protos.RateResult_Error{Error: st.Proto()}
+		} else {
+			result.Result = &protos.RateResult_Rate{Rate: r.Rate.Price(side)}
+			result.Path = r.Rate.Path
+		}
+
+		resp.Rates = append(resp.Rates, result)
+	}
+
+	return resp, nil
+}
+
+func (c *Currency) GetHistoricalRate(ctx context.Context, hr *protos.HistoricalRateRequest) (*protos.HistoricalRateResponse, error) {
+	c.log.Info("Handle GetHistoricalRate", "base", hr.GetBase(), "destination", hr.GetDestination(), "base_code", hr.GetBaseCode(), "destination_code", hr.GetDestinationCode())
+
+	base, dest, st := c.currencyPair(hr, hr.Base, hr.BaseCode, hr.Destination, hr.DestinationCode)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	var at time.Time
+	switch v := hr.At.(type) {
+	case *protos.HistoricalRateRequest_Time:
+		if err := v.Time.CheckValid(); err != nil {
+			return nil, newStatusError(codes.InvalidArgument, hr, "Invalid time: %s", err)
+		}
+		at = v.Time.AsTime()
+	case *protos.HistoricalRateRequest_Date:
+		d, err := time.Parse("2006-01-02", v.Date)
+		if err != nil {
+			return nil, newStatusError(codes.InvalidArgument, hr, "Invalid date %q, expected YYYY-MM-DD", v.Date)
+		}
+		// the last rate recorded on that day
+		at = d.Add(24*time.Hour - time.Nanosecond)
+	default:
+		return nil, newStatusError(codes.InvalidArgument, hr, "Either a time or a date must be specified")
+	}
+
+	rp, err := c.rates.GetHistoricalRate(base, dest, at)
+	switch {
+	case errors.Is(err, data.ErrNoRateHistory):
+		md := map[string]string{"time": at.Format(time.RFC3339)}
+		return nil, newReasonStatus(codes.NotFound, hr, ErrorReasonNoRateHistory, md, "No rate recorded at %s", at.Format(time.RFC3339)).Err()
+	case errors.Is(err, data.ErrRateNotFound):
+		// the currency had no rate yet at that time
+		md := map[string]string{"base": base, "destination": dest, "time": at.Format(time.RFC3339)}
+		return nil, newReasonStatus(codes.NotFound, hr, ErrorReasonRateNotFound, md, "No rate of %s to %s recorded at %s", base, dest, at.Format(time.RFC3339)).Err()
+	case err != nil:
+		return nil, newStatusError(codes.Internal, hr, "Unable to read the rate history: %s", err)
+	}
+
+	return &protos.HistoricalRateResponse{
+		Base:            currencyEnum(base),
+		Destination:     currencyEnum(dest),
+		BaseCode:        base,
+		DestinationCode: dest,
+		Rate:            rp.Rate,
+		Time:            timestamppb.New(rp.Time),
+		Path:            c.rates.PivotPath(base, dest),
+	}, nil
+}
+
+func (c *Currency) GetRateSeries(ctx context.Context, sr *protos.RateSeriesRequest) (*protos.RateSeriesResponse, error) {
+	c.log.Info("Handle GetRateSeries", "base", sr.GetBase(), "destination", sr.GetDestination(), "base_code", sr.GetBaseCode(), "destination_code", sr.GetDestinationCode())
+
+	base, dest, st := c.currencyPair(sr, sr.Base, sr.BaseCode, sr.Destination, sr.DestinationCode)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	start, end, err := timeRange(sr, sr.Start, sr.End)
 	if err != nil {
 		return nil, err
 	}
 
-	return &protos.RateResponse{Base: rr.Base, Destination: rr.Destination, Rate: rate}, nil
+	points, err := c.rates.GetRateSeries(base, dest, start, end)
+	if err != nil {
+		return nil, seriesError(sr, base, dest, err)
+	}
+
+	resp := &protos.RateSeriesResponse{Base: currencyEnum(base), Destination: currencyEnum(dest), BaseCode: base, DestinationCode: dest, Path: c.rates.PivotPath(base, dest)}
+	for _, p := range points {
+		resp.Rates = append(resp.Rates, &protos.RatePoint{Time: timestamppb.New(p.Time), Rate: p.Rate})
+	}
+
+	return resp, nil
+}
+
+func (c *Currency) GetCandles(ctx context.Context, cr *protos.CandlesRequest) (*protos.CandlesResponse, error) {
+	c.log.Info("Handle GetCandles", "base", cr.GetBase(), "destination", cr.GetDestination(), "base_code", cr.GetBaseCode(), "destination_code", cr.GetDestinationCode(), "interval", cr.GetInterval())
+
+	base, dest, st := c.currencyPair(cr, cr.Base, cr.BaseCode, cr.Destination, cr.DestinationCode)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	start, end, err := timeRange(cr, cr.Start, cr.End)
+	if err != nil {
+		return nil, err
+	}
+
+	var interval time.Duration
+	switch cr.Interval {
+	case protos.CandleInterval_ONE_MINUTE:
+		interval = time.Minute
+	case protos.CandleInterval_ONE_HOUR:
+		interval = time.Hour
+	case protos.CandleInterval_ONE_DAY:
+		interval = 24 * time.Hour
+	default:
+		return nil, newStatusError(codes.InvalidArgument, cr, "Unknown candle interval %s", cr.Interval.String())
+	}
+
+	candles, err := c.rates.GetCandles(base, dest, start, end, interval)
+	if err != nil {
+		return nil, seriesError(cr, base, dest, err)
+	}
+
+	resp := &protos.CandlesResponse{Base: currencyEnum(base), Destination: currencyEnum(dest), BaseCode: base, DestinationCode: dest, Interval: cr.Interval, Path: c.rates.PivotPath(base, dest)}
+	for _, cd := range candles {
+		resp.Candles = append(resp.Candles, &protos.Candle{
+			Start: timestamppb.New(cd.Start),
+			Open:  cd.Open,
+			High:  cd.High,
+			Low:   cd.Low,
+			Close: cd.Close,
+			Ticks: int64(cd.Ticks),
+		})
+	}
+
+	return resp, nil
+}
+
+// timeRange validates the range of a history request, end must not be before start
+func timeRange(req protoiface.MessageV1, start, end *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	if start == nil || end == nil {
+		return time.Time{}, time.Time{}, newStatusError(codes.InvalidArgument, req, "Both start and end of the range must be specified")
+	}
+
+	for _, ts := range []*timestamppb.Timestamp{start, end} {
+		if err := ts.CheckValid(); err != nil {
+			return time.Time{}, time.Time{}, newStatusError(codes.InvalidArgument, req, "Invalid range: %s", err)
+		}
+	}
+
+	s, e := start.AsTime(), end.AsTime()
+	if e.Before(s) {
+		return time.Time{}, time.Time{}, newStatusError(codes.InvalidArgument, req, "End of the range %s is before its start %s", e.Format(time.RFC3339), s.Format(time.RFC3339))
+	}
+
+	return s, e, nil
+}
+
+// seriesError translates an error reading the rate history, the range holds
+// a rate recorded before one of the currencies had a rate
+func seriesError(req protoiface.MessageV1, base, dest string, err error) error {
+	if errors.Is(err, data.ErrRateNotFound) {
+		md := map[string]string{"base": base, "destination": dest}
+		return newReasonStatus(codes.NotFound, req, ErrorReasonRateNotFound, md, "No rates of %s to %s recorded in the range", base, dest).Err()
+	}
+
+	return newStatusError(codes.Internal, req, "Unable to read the rate history: %s", err)
+}
+
+func (c *Currency) ListCurrencies(ctx context.Context, lr *protos.ListCurrenciesRequest) (*protos.ListCurrenciesResponse, error) {
+	c.log.Info("Handle ListCurrencies")
+
+	resp := &protos.ListCurrenciesResponse{}
+	for _, cs := range c.rates.Currencies() {
+		resp.Currencies = append(resp.Currencies, &protos.CurrencyInfo{
+			Code:       cs.Code,
+			Name:       cs.Name,
+			MinorUnits: int32(cs.Digits),
+			Symbol:     cs.Symbol,
+			Updated:    timestamppb.New(cs.Updated),
+		})
+	}
+
+	return resp, nil
+}
+
+func (c *Currency) Convert(ctx context.Context, cr *protos.ConvertRequest) (*protos.ConvertResponse, error) {
+	c.log.Info("Handle Convert", "base", cr.GetBase(), "destination", cr.GetDestination(), "base_code", cr.GetBaseCode(), "destination_code", cr.GetDestinationCode())
+
+	base, dest, st := c.currencyPair(cr, cr.Base, cr.BaseCode, cr.Destination, cr.DestinationCode)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	if cr.Amount == nil {
+		return nil, newStatusError(codes.InvalidArgument, cr, "Amount must be specified")
+	}
+
+	amount := data.Money{Units: cr.Amount.GetUnits(), Nanos: cr.Amount.GetNanos()}
+	if err := amount.Validate(); err != nil {
+		return nil, newStatusError(codes.InvalidArgument, cr, "Invalid amount: %s", err)
+	}
+
+	var mode data.RoundingMode
+	switch cr.Rounding {
+	case protos.RoundingMode_ROUND_HALF_EVEN:
+		mode = data.RoundHalfEven
+	case protos.RoundingMode_ROUND_HALF_UP:
+		mode = data.RoundHalfUp
+	case protos.RoundingMode_ROUND_DOWN:
+		mode = data.RoundDown
+	default:
+		return nil, newStatusError(codes.InvalidArgument, cr, "Unknown rounding mode %s", cr.Rounding.String())
+	}
+
+	side, err := toSide(cr.Side)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, cr, "%s", err)
+	}
+
+	m, rate, err := c.rates.Convert(amount, base, dest, cr.GetTier(), side, mode)
+	switch {
+	case errors.Is(err, data.ErrUnknownTier):
+		md := map[string]string{"tier": cr.GetTier()}
+		return nil, newReasonStatus(codes.InvalidArgument, cr, ErrorReasonUnknownTier, md, "Unknown customer tier %s", cr.GetTier()).Err()
+	case errors.Is(err, data.ErrRateNotFound):
+		md := map[string]string{"base": base, "destination": dest}
+		return nil, newReasonStatus(codes.NotFound, cr, ErrorReasonRateNotFound, md, "Rate not found for %s to %s", base, dest).Err()
+	case errors.Is(err, data.ErrAmountOverflow):
+		md := map[string]string{"destination": dest}
+		return nil, newReasonStatus(codes.InvalidArgument, cr, ErrorReasonAmountOverflow, md, "Converted amount overflows in %s", dest).Err()
+	case err != nil:
+		return nil, newStatusError(codes.Internal, cr, "Unable to convert %s to %s: %s", base, dest, err)
+	}
+
+	if st := c.checkAge(cr, rate, time.Now()); st != nil {
+		return nil, st.Err()
+	}
+
+	return &protos.ConvertResponse{
+		Amount:          &protos.Money{Units: m.Units, Nanos: m.Nanos},
+		Base:            currencyEnum(base),
+		Destination:     currencyEnum(dest),
+		BaseCode:        base,
+		DestinationCode: dest,
+		Rate:            rate.Price(side),
+		Path:            rate.Path,
+	}, nil
 }
 
 func (c *Currency) SubscribeRates(src protos.Currency_SubscribeRatesServer) error {
-	// handle client messages
+	s := c.broadcaster.newSubscriber(src)
+	c.subscriptions.Register(s)
+
+	// the subscriptions of a client end with its stream, closing the
+	// subscriber ends its delivery on every path
+	defer c.removeSubscriptions(src)
+	defer s.Close()
+
+	sent := make(chan error, 1)
+	go func() { sent <- s.run() }()
+
+	received := make(chan error, 1)
+	go func() { received <- c.handleRequests(s) }()
+
+	select {
+	case err := <-received:
+		if err == errSlowConsumer {
+			c.log.Warn("Disconnecting slow subscriber")
+		}
+		if err != nil {
+			// the stream is broken, the queued messages are discarded
+			s.Abort(err)
+			return err
+		}
+
+		// deliver the replies to the last requests before closing the stream
+		s.Close()
+		return <-sent
+	case err := <-sent:
+		if err == errSlowConsumer {
+			c.log.Warn("Disconnecting slow subscriber")
+		}
+		return err
+	}
+}
+
+// handleRequests reads the requests of the client until it closes the stream
+func (c *Currency) handleRequests(s *subscriber) error {
 	for {
-		rr, err := src.Recv()
+		req, err := s.stream.Recv()
 		// io.EOF signals that the client has closed the connection
 		if err == io.EOF {
 			c.log.Info("Client has closed connection")
-			break
+			return nil
 		}
 
 		// transport between client and server is unavailable
@@ -99,49 +541,110 @@ func (c *Currency) SubscribeRates(src protos.Currency_SubscribeRatesServer) erro
 			return err
 		}
 
-		c.log.Info("Handle client request", "request", rr)
+		c.log.Info("Handle client request", "request", req)
 
-		rrs, ok := c.subscriptions[src]
-		if !ok {
-			rrs = []*protos.RateRequest{}
+		var resp *protos.StreamingRateResponse
+		switch m := req.Message.(type) {
+		case *protos.StreamingRateRequest_Subscribe:
+			resp = c.subscribe(s, m.Subscribe)
+		case *protos.StreamingRateRequest_Unsubscribe:
+			resp = c.unsubscribe(s, m.Unsubscribe)
+		case *protos.StreamingRateRequest_ListSubscriptions:
+			resp = ackResponse(protos.SubscriptionAck_LIST_SUBSCRIPTIONS, nil, requests(s.Subscriptions()))
+		default:
+			resp = errorResponse(status.New(codes.InvalidArgument, "Unknown streaming request"))
 		}
 
-		// check that subscription does not exist
-		var validationError *status.Status
-		for _, v := range rrs {
-			if v.Base == rr.Base && v.Destination == rr.Destination {
-				// subscription exists
-				validationError := status.Newf(
-					codes.AlreadyExists,
-					"Unable to subscribe for currency as subscription already exists",
-				)
+		err = s.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
 
-				// add the original request as metadata
-				validationError, err = validationError.WithDetails(rr)
-				if err != nil {
-					c.log.Error("Unable to add metadata to error", "error", err)
-					break
-				}
+// subscribe adds a subscription for the client
+func (c *Currency) subscribe(s *subscriber, rr *protos.RateRequest) *protos.StreamingRateResponse {
+	base, dest, st := c.currencyPair(rr, rr.Base, rr.BaseCode, rr.Destination, rr.DestinationCode)
+	if st != nil {
+		return errorResponse(st)
+	}
 
-				break
-			}
-		}
+	if err := validateConditions(rr.Conditions); err != nil {
+		return errorResponse(newStatus(codes.InvalidArgument, rr, "Invalid subscription conditions: %s", err))
+	}
 
-		// if a validation error return error and continue
-		if validationError != nil {
-			src.Send(&protos.StreamingRateResponse{
-				Message: &protos.StreamingRateResponse_Error{
-					Error: validationError.Proto(),
-				},
-			},
-			)
-			continue
-		}
+	side, err := toSide(rr.Side)
+	if err != nil {
+		return errorResponse(newStatus(codes.InvalidArgument, rr, "%s", err))
+	}
+
+	// the current rate is the reference for the conditions of the subscription
+	rate, err := c.rates.Quote(base, dest, rr.GetTier())
+	if errors.Is(err, data.ErrUnknownTier) {
+		return errorResponse(newStatus(codes.InvalidArgument, rr, "Unknown customer tier %s", rr.GetTier()))
+	}
+	if err != nil {
+		return errorResponse(newStatus(codes.NotFound, rr, "Rate not found for %s to %s", base, dest))
+	}
 
-		// all ok
-		rrs = append(rrs, rr)
-		c.subscriptions[src] = rrs
+	subs, err := c.subscriptions.Subscribe(s, newSubscription(rr, base, dest, side, rate.Price(side)))
+	if err != nil {
+		return errorResponse(newStatus(codes.AlreadyExists, rr, "Unable to subscribe for currency as subscription already exists"))
 	}
 
-	return nil
+	return ackResponse(protos.SubscriptionAck_SUBSCRIBE, rr, requests(subs))
+}
+
+// unsubscribe removes a subscription of the client
+func (c *Currency) unsubscribe(s *subscriber, rr *protos.RateRequest) *protos.StreamingRateResponse {
+	base, dest, st := c.currencyPair(rr, rr.Base, rr.BaseCode, rr.Destination, rr.DestinationCode)
+	if st != nil {
+		return errorResponse(st)
+	}
+
+	subs, err := c.subscriptions.Unsubscribe(s, base, dest)
+	if err != nil {
+		return errorResponse(newStatus(codes.NotFound, rr, "Unable to unsubscribe as subscription does not exist"))
+	}
+
+	return ackResponse(protos.SubscriptionAck_UNSUBSCRIBE, rr, requests(subs))
+}
+
+// removeSubscriptions drops the client and all of its subscriptions
+func (c *Currency) removeSubscriptions(src protos.Currency_SubscribeRatesServer) {
+	n := c.subscriptions.Unregister(src)
+	c.log.Info("Removed client subscriptions", "subscriptions", n)
+}
+
+func ackResponse(op protos.SubscriptionAck_Operation, rr *protos.RateRequest, rrs []*protos.RateRequest) *protos.StreamingRateResponse {
+	return &protos.StreamingRateResponse{
+		Message: &protos.StreamingRateResponse_Ack{
+			Ack: &protos.SubscriptionAck{Op: op, Request: rr, Subscriptions: rrs},
+		},
+	}
+}
+
+func errorResponse(st *status.Status) *protos.StreamingRateResponse {
+	return &protos.StreamingRateResponse{
+		Message: &protos.StreamingRateResponse_Error{
+			Error: st.Proto(),
+		},
+	}
+}
+
+// newStatus creates a status with the original request attached as metadata
+func newStatus(code codes.Code, req protoiface.MessageV1, format string, a ...interface{}) *status.Status {
+	st := status.Newf(code, format, a...)
+
+	wd, err := st.WithDetails(req)
+	if err != nil {
+		return st
+	}
+
+	return wd
+}
+
+// newStatusError creates a gRPC error with the original request attached as metadata
+func newStatusError(code codes.Code, req protoiface.MessageV1, format string, a ...interface{}) error {
+	return newStatus(code, req, format, a...).Err()
 }
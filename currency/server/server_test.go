@@ -0,0 +1,426 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ellofae/gRPC-Bakery-Microservice/currency/data"
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestServer starts the currency service on an in-memory listener
+func newTestServer(t *testing.T, interval time.Duration) (*Currency, protos.CurrencyClient) {
+	log := hclog.NewNullLogger()
+
+	sim, err := data.NewSimulator(data.RandomWalk{Bound: 0.1}, 0.01, nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rates := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1, "JPY": 160, "GBP": 0.85}), "EUR", data.NewHistory(0), sim, nil)
+
+	cs := NewCurrency(rates, NewBroadcaster(64, DropOldest), interval, 0, log)
+	t.Cleanup(rates.Close)
+
+	l := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer()
+	protos.RegisterCurrencyServer(gs, cs)
+	go gs.Serve(l)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return cs, protos.NewCurrencyClient(conn)
+}
+
+func subscribeRequest(base, dest protos.Currencies) *protos.StreamingRateRequest {
+	return &protos.StreamingRateRequest{
+		Message: &protos.StreamingRateRequest_Subscribe{Subscribe: &protos.RateRequest{Base: base, Destination: dest}},
+	}
+}
+
+func unsubscribeRequest(base, dest protos.Currencies) *protos.StreamingRateRequest {
+	return &protos.StreamingRateRequest{
+		Message: &protos.StreamingRateRequest_Unsubscribe{Unsubscribe: &protos.RateRequest{Base: base, Destination: dest}},
+	}
+}
+
+func TestSubscriptionManagement(t *testing.T) {
+	_, cc := newTestServer(t, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := cc.SubscribeRates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name string
+		req  *protos.StreamingRateRequest
+		code codes.Code
+		subs int
+	}{
+		{"subscribe", subscribeRequest(protos.Currencies_EUR, protos.Currencies_USD), codes.OK, 1},
+		{"subscribe second pair", subscribeRequest(protos.Currencies_EUR, protos.Currencies_GBP), codes.OK, 2},
+		{"duplicate subscription", subscribeRequest(protos.Currencies_EUR, protos.Currencies_USD), codes.AlreadyExists, 0},
+		{"list", &protos.StreamingRateRequest{Message: &protos.StreamingRateRequest_ListSubscriptions{}}, codes.OK, 2},
+		{"unsubscribe", unsubscribeRequest(protos.Currencies_EUR, protos.Currencies_USD), codes.OK, 1},
+		{"unsubscribe unknown", unsubscribeRequest(protos.Currencies_EUR, protos.Currencies_USD), codes.NotFound, 0},
+	}
+
+	for _, s := range steps {
+		err := sub.Send(s.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := sub.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.code != codes.OK {
+			if resp.GetError().GetCode() != int32(s.code) {
+				t.Fatalf("%s: expected %s, got %v", s.name, s.code, resp)
+			}
+			continue
+		}
+
+		if resp.GetAck() == nil || len(resp.GetAck().GetSubscriptions()) != s.subs {
+			t.Fatalf("%s: expected an ack with %d subscriptions, got %v", s.name, s.subs, resp)
+		}
+	}
+}
+
+func TestSubscriptionsRemovedOnClose(t *testing.T) {
+	cs, cc := newTestServer(t, 5*time.Second)
+
+	sub, err := cc.SubscribeRates(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = sub.Send(subscribeRequest(protos.Currencies_EUR, protos.Currencies_USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = sub.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sub.CloseSend()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(cs.subscriptions.Subscribers()) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("subscriptions were not removed after the stream closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSubscribersStoppedOnCancel(t *testing.T) {
+	cs, cc := newTestServer(t, 5*time.Second)
+
+	var subs []*subscriber
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		sub, err := cc.SubscribeRates(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = sub.Send(subscribeRequest(protos.Currencies_EUR, protos.Currencies_USD))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = sub.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, s := range cs.subscriptions.Subscribers() {
+			subs = append(subs, s)
+		}
+		cancel()
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(cs.subscriptions.Subscribers()) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected no subscribers after the streams were cancelled, got %d", len(cs.subscriptions.Subscribers()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the delivery of every cancelled stream stopped
+	for _, s := range subs {
+		select {
+		case <-s.done:
+		case <-time.After(time.Second):
+			t.Fatal("expected the delivery to stop after the stream was cancelled")
+		}
+	}
+}
+
+func TestGetRateMetadataAndMaxAge(t *testing.T) {
+	log := hclog.NewNullLogger()
+
+	// without a simulator the rates only change when they are loaded
+	rates := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1}), "EUR", data.NewHistory(0), nil, nil)
+
+	cs := NewCurrency(rates, NewBroadcaster(64, DropOldest), time.Hour, 50*time.Millisecond, log)
+	t.Cleanup(rates.Close)
+	rr := &protos.RateRequest{Base: protos.Currencies_EUR, Destination: protos.Currencies_USD}
+
+	resp, err := cs.GetRate(context.Background(), rr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Source != "fixed" || resp.AsOf == nil || resp.Sequence == 0 {
+		t.Fatalf("expected rate metadata, got %v", resp)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = cs.GetRate(context.Background(), rr)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a stale rate, got %v", err)
+	}
+}
+
+func TestGetRateSide(t *testing.T) {
+	log := hclog.NewNullLogger()
+
+	rates := data.NewRates(log, data.NewFixedProvider(map[string]float64{"USD": 1.1}), "EUR", data.NewHistory(0), nil, nil)
+
+	err := rates.SetSpreads(data.SpreadRules{Default: 0.02, Tier: map[string]float64{"WHOLESALE": 0.5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cs := NewCurrency(rates, NewBroadcaster(64, DropOldest), time.Hour, 0, log)
+	t.Cleanup(rates.Close)
+
+	resp, err := cs.GetRate(context.Background(), &protos.RateRequest{Base: protos.Currencies_EUR, Destination: protos.Currencies_USD, Side: protos.Side_SIDE_BID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Rate != resp.Bid || resp.Bid >= resp.Mid || resp.Ask <= resp.Mid {
+		t.Fatalf("expected the bid rate below the mid rate, got %v", resp)
+	}
+
+	ws, err := cs.GetRate(context.Background(), &protos.RateRequest{Base: protos.Currencies_EUR, Destination: protos.Currencies_USD, Side: protos.Side_SIDE_BID, Tier: "wholesale"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ws.Rate <= resp.Rate {
+		t.Fatalf("expected a narrower spread for the wholesale tier, got %f and %f", ws.Rate, resp.Rate)
+	}
+
+	_, err = cs.GetRate(context.Background(), &protos.RateRequest{Base: protos.Currencies_EUR, Destination: protos.Currencies_USD, Tier: "unknown"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown tier, got %v", err)
+	}
+}
+
+// errorInfo returns the ErrorInfo of a gRPC error, nil when it has none
+func errorInfo(err error) *errdetails.ErrorInfo {
+	for _, d := range status.Convert(err).Details() {
+		if ei, ok := d.(*errdetails.ErrorInfo); ok {
+			return ei
+		}
+	}
+
+	return nil
+}
+
+func TestCurrencyCodes(t *testing.T) {
+	_, cc := newTestServer(t, time.Hour)
+
+	resp, err := cc.GetRate(context.Background(), &protos.RateRequest{BaseCode: "eur", DestinationCode: "USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.BaseCode != "EUR" || resp.DestinationCode != "USD" || resp.Destination != protos.Currencies_USD {
+		t.Fatalf("expected the pair EUR to USD, got %v", resp)
+	}
+
+	if len(resp.Path) != 2 || resp.Path[0] != "EUR" || resp.Path[1] != "USD" {
+		t.Fatalf("expected the direct path from EUR to USD, got %v", resp.Path)
+	}
+
+	_, err = cc.GetRate(context.Background(), &protos.RateRequest{Base: protos.Currencies_USD, DestinationCode: "XYZ"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown code, got %v", err)
+	}
+
+	info := errorInfo(err)
+	if info == nil || info.Reason != ErrorReasonUnknownCurrency || info.Metadata["code"] != "XYZ" || info.Metadata["field"] != "DestinationCode" {
+		t.Fatalf("expected an ErrorInfo for the unknown code, got %v", info)
+	}
+
+	// a valid ISO code the service has no rate for
+	_, err = cc.GetRate(context.Background(), &protos.RateRequest{BaseCode: "EUR", DestinationCode: "KES"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a currency without a rate, got %v", err)
+	}
+
+	info = errorInfo(err)
+	if info == nil || info.Reason != ErrorReasonRateNotFound || info.Metadata["destination"] != "KES" {
+		t.Fatalf("expected an ErrorInfo for the missing rate, got %v", info)
+	}
+
+	_, err = cc.GetRate(context.Background(), &protos.RateRequest{BaseCode: "EUR", DestinationCode: "USD", Tier: "platinum"})
+	if info := errorInfo(err); status.Code(err) != codes.InvalidArgument || info == nil || info.Reason != ErrorReasonUnknownTier {
+		t.Fatalf("expected an ErrorInfo for the unknown tier, got %v", err)
+	}
+}
+
+func TestUnitCodes(t *testing.T) {
+	cs, cc := newTestServer(t, time.Hour)
+
+	err := cs.rates.AddUnits(data.UnitSource{Provider: data.NewFixedProvider(map[string]float64{"PTS": 100}), Pivot: "EUR"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := cc.GetRate(context.Background(), &protos.RateRequest{BaseCode: "EUR", DestinationCode: "PTS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Rate != 100 || resp.DestinationCode != "PTS" {
+		t.Fatalf("expected 100 points per EUR, got %v", resp)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	_, cc := newTestServer(t, time.Hour)
+
+	tests := []struct {
+		name   string
+		req    *protos.ConvertRequest
+		code   codes.Code
+		reason string
+	}{
+		{"unknown tier", &protos.ConvertRequest{BaseCode: "EUR", DestinationCode: "USD", Amount: &protos.Money{Units: 1}, Tier: "unknown"}, codes.InvalidArgument, ErrorReasonUnknownTier},
+		{"no rate", &protos.ConvertRequest{BaseCode: "EUR", DestinationCode: "KES", Amount: &protos.Money{Units: 1}}, codes.NotFound, ErrorReasonRateNotFound},
+		{"overflow", &protos.ConvertRequest{BaseCode: "EUR", DestinationCode: "JPY", Amount: &protos.Money{Units: 1 << 62}}, codes.InvalidArgument, ErrorReasonAmountOverflow},
+	}
+
+	for _, tc := range tests {
+		_, err := cc.Convert(context.Background(), tc.req)
+		if status.Code(err) != tc.code {
+			t.Errorf("%s: expected %s, got %v", tc.name, tc.code, err)
+			continue
+		}
+
+		if info := errorInfo(err); info == nil || info.Reason != tc.reason || info.Domain != errorDomain {
+			t.Errorf("%s: expected an ErrorInfo with reason %s, got %v", tc.name, tc.reason, info)
+		}
+	}
+}
+
+func TestGetHistoricalRateErrors(t *testing.T) {
+	_, cc := newTestServer(t, time.Hour)
+
+	now := timestamppb.Now()
+	hr := func(dest string, at *protos.HistoricalRateRequest) *protos.HistoricalRateRequest {
+		at.BaseCode, at.DestinationCode = "EUR", dest
+		return at
+	}
+
+	_, err := cc.GetHistoricalRate(context.Background(), hr("USD", &protos.HistoricalRateRequest{At: &protos.HistoricalRateRequest_Time{Time: now}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		req    *protos.HistoricalRateRequest
+		code   codes.Code
+		reason string
+	}{
+		{"invalid time", hr("USD", &protos.HistoricalRateRequest{At: &protos.HistoricalRateRequest_Time{Time: &timestamppb.Timestamp{Nanos: -1}}}), codes.InvalidArgument, ""},
+		{"invalid date", hr("USD", &protos.HistoricalRateRequest{At: &protos.HistoricalRateRequest_Date{Date: "12/05/2023"}}), codes.InvalidArgument, ""},
+		{"before the history", hr("USD", &protos.HistoricalRateRequest{At: &protos.HistoricalRateRequest_Date{Date: "2000-01-01"}}), codes.NotFound, ErrorReasonNoRateHistory},
+		{"no rate", hr("KES", &protos.HistoricalRateRequest{At: &protos.HistoricalRateRequest_Time{Time: now}}), codes.NotFound, ErrorReasonRateNotFound},
+	}
+
+	for _, tc := range tests {
+		_, err := cc.GetHistoricalRate(context.Background(), tc.req)
+		if status.Code(err) != tc.code {
+			t.Errorf("%s: expected %s, got %v", tc.name, tc.code, err)
+			continue
+		}
+
+		if info := errorInfo(err); tc.reason != "" && (info == nil || info.Reason != tc.reason) {
+			t.Errorf("%s: expected an ErrorInfo with reason %s, got %v", tc.name, tc.reason, info)
+		}
+	}
+}
+
+func TestHistoryRangeErrors(t *testing.T) {
+	_, cc := newTestServer(t, time.Hour)
+
+	start, end := timestamppb.New(time.Now().Add(-time.Hour)), timestamppb.New(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name       string
+		dest       string
+		start, end *timestamppb.Timestamp
+		code       codes.Code
+	}{
+		{"no end", "USD", start, nil, codes.InvalidArgument},
+		{"invalid start", "USD", &timestamppb.Timestamp{Nanos: -1}, end, codes.InvalidArgument},
+		{"end before start", "USD", end, start, codes.InvalidArgument},
+		{"no rate", "KES", start, end, codes.NotFound},
+		{"valid", "USD", start, end, codes.OK},
+	}
+
+	for _, tc := range tests {
+		_, err := cc.GetRateSeries(context.Background(), &protos.RateSeriesRequest{BaseCode: "EUR", DestinationCode: tc.dest, Start: tc.start, End: tc.end})
+		if status.Code(err) != tc.code {
+			t.Errorf("series %s: expected %s, got %v", tc.name, tc.code, err)
+		}
+
+		_, err = cc.GetCandles(context.Background(), &protos.CandlesRequest{BaseCode: "EUR", DestinationCode: tc.dest, Start: tc.start, End: tc.end})
+		if status.Code(err) != tc.code {
+			t.Errorf("candles %s: expected %s, got %v", tc.name, tc.code, err)
+		}
+	}
+
+	_, err := cc.GetCandles(context.Background(), &protos.CandlesRequest{BaseCode: "EUR", DestinationCode: "USD", Start: start, End: end, Interval: 7})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown interval, got %v", err)
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"sync"
 	"time"
 
 	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
 	"github.com/go-playground/validator"
 	"github.com/hashicorp/go-hclog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -77,12 +79,17 @@ func validateTitle(fl validator.FieldLevel) bool {
 type ProductsDB struct {
 	currency protos.CurrencyClient
 	log      hclog.Logger
-	rates    map[string]float64
 	client   protos.Currency_SubscribeRatesClient
+
+	// mu guards the caches and the subscription stream, requests and rate
+	// updates use them concurrently
+	mu         sync.RWMutex
+	rates      map[string]float64
+	currencies map[string]bool
 }
 
 func NewProductsDB(c protos.CurrencyClient, l hclog.Logger) *ProductsDB {
-	pb := &ProductsDB{c, l, make(map[string]float64), nil}
+	pb := &ProductsDB{currency: c, log: l, rates: make(map[string]float64), currencies: make(map[string]bool)}
 
 	go pb.handleUpdates()
 	return pb
@@ -94,7 +101,9 @@ func (p *ProductsDB) handleUpdates() {
 		p.log.Error("Unable to subscribe for rates", "error", err)
 		return
 	}
+	p.mu.Lock()
 	p.client = sub
+	p.mu.Unlock()
 
 	for {
 		rr, err := sub.Recv()
@@ -105,13 +114,15 @@ func (p *ProductsDB) handleUpdates() {
 		}
 
 		if resp := rr.GetRateResponse(); resp != nil {
-			p.log.Info("Recieved updated rate from server", "dest", resp.GetDestination().String())
+			p.log.Info("Recieved updated rate from server", "dest", resp.GetDestinationCode())
 			if err != nil {
 				p.log.Error("Error receiving message", "error", err)
 				return
 			}
 
-			p.rates[resp.Destination.String()] = resp.Rate
+			p.mu.Lock()
+			p.rates[resp.GetDestinationCode()] = resp.Rate
+			p.mu.Unlock()
 		}
 	}
 }
@@ -196,6 +207,8 @@ func (p *Product) FromJSON(r io.Reader) error {
 
 var ErrProductNotFound = fmt.Errorf("Product was not found")
 
+var ErrUnsupportedCurrency = fmt.Errorf("Currency is not supported by the currency server")
+
 func getProductPosition(id int) (int, error) {
 	for i, p := range productList {
 		if p.ID == id {
@@ -222,38 +235,96 @@ func findIndexByProductID(id int) int {
 
 func (p *ProductsDB) getRate(dest string) (float64, error) {
 	// if cached return
-	if r, ok := p.rates[dest]; ok {
+	p.mu.RLock()
+	r, ok := p.rates[dest]
+	p.mu.RUnlock()
+	if ok {
 		return r, nil
 	}
 
+	err := p.validateCurrency(dest)
+	if err != nil {
+		return -1, err
+	}
+
+	// codes are sent as strings, the enum would turn an unknown code into EUR
 	rr := &protos.RateRequest{
-		Base:        protos.Currencies(protos.Currencies_value["EUR"]),
-		Destination: protos.Currencies(protos.Currencies_value[dest]),
+		BaseCode:        "EUR",
+		DestinationCode: dest,
 	}
 
 	// get initial rate
 	resp, err := p.currency.GetRate(context.Background(), rr)
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
-			md := s.Details()[0].(protos.RateRequest)
+			for _, d := range s.Details() {
+				ei, ok := d.(*errdetails.ErrorInfo)
+				if !ok {
+					continue
+				}
+
+				switch ei.GetReason() {
+				case "UNKNOWN_CURRENCY":
+					return -1, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, ei.GetMetadata()["code"])
+				case "RATE_NOT_FOUND":
+					return -1, fmt.Errorf("%w: no rate for %s", ErrUnsupportedCurrency, dest)
+				}
+			}
 
 			if s.Code() == codes.InvalidArgument {
-				return -1, fmt.Errorf("unable to get rate from the currency server, destincation and base currencies cannot be the same, base: %s, dest: %s", md.Base.String(), md.Destination.String())
+				return -1, fmt.Errorf("unable to get rate from the currency server, destincation and base currencies cannot be the same, base: %s, dest: %s", rr.BaseCode, rr.DestinationCode)
 			}
-			return -1, fmt.Errorf("unable to get rate from the currency server, base: %s, dest: %s", md.Base.String(), md.Destination.String())
+			return -1, fmt.Errorf("unable to get rate from the currency server, base: %s, dest: %s: %s", rr.BaseCode, rr.DestinationCode, s.Message())
 		}
 
 		return -1, err
 	}
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.rates[dest] = resp.Rate // update cache
 
-	// subscribe for updated
-	p.client.Send(rr)
+	// subscribe for updated, a stream takes a single sender at a time
+	if p.client != nil {
+		p.client.Send(&protos.StreamingRateRequest{
+			Message: &protos.StreamingRateRequest_Subscribe{Subscribe: rr},
+		})
+	}
 
 	return resp.Rate, err
 }
 
+// validateCurrency checks that the currency server has a rate for the currency
+func (p *ProductsDB) validateCurrency(cur string) error {
+	p.mu.RLock()
+	known := p.currencies[cur]
+	p.mu.RUnlock()
+	if known {
+		return nil
+	}
+
+	// the list may be outdated, refresh it before rejecting the currency
+	resp, err := p.currency.ListCurrencies(context.Background(), &protos.ListCurrenciesRequest{})
+	if err != nil {
+		return err
+	}
+
+	currencies := make(map[string]bool, len(resp.Currencies))
+	for _, c := range resp.GetCurrencies() {
+		currencies[c.GetCode()] = true
+	}
+	p.mu.Lock()
+	p.currencies = currencies
+	p.mu.Unlock()
+
+	if !currencies[cur] {
+		return fmt.Errorf("%w: %s", ErrUnsupportedCurrency, cur)
+	}
+
+	return nil
+}
+
 var productList = []*Product{
 	&Product{
 		ID:          1,
@@ -1,7 +1,15 @@
 package data
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
+
+	protos "github.com/ellofae/gRPC-Bakery-Microservice/currency/protos/currency"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
 )
 
 func TestValidateStructTitle(t *testing.T) {
@@ -131,3 +139,77 @@ func TestValidateStructSKU(t *testing.T) {
 		}
 	})
 }
+
+// fakeCurrency serves a fixed rate for the currencies it lists
+type fakeCurrency struct {
+	protos.CurrencyClient
+}
+
+func (fakeCurrency) GetRate(context.Context, *protos.RateRequest, ...grpc.CallOption) (*protos.RateResponse, error) {
+	return &protos.RateResponse{Rate: 2}, nil
+}
+
+func (fakeCurrency) ListCurrencies(context.Context, *protos.ListCurrenciesRequest, ...grpc.CallOption) (*protos.ListCurrenciesResponse, error) {
+	return &protos.ListCurrenciesResponse{Currencies: []*protos.CurrencyInfo{{Code: "USD"}, {Code: "GBP"}, {Code: "JPY"}}}, nil
+}
+
+func (fakeCurrency) SubscribeRates(ctx context.Context, _ ...grpc.CallOption) (protos.Currency_SubscribeRatesClient, error) {
+	return &fakeStream{ctx: ctx}, nil
+}
+
+// fakeStream accepts subscriptions and never sends an update
+type fakeStream struct {
+	protos.Currency_SubscribeRatesClient
+	ctx context.Context
+}
+
+func (s *fakeStream) Send(*protos.StreamingRateRequest) error {
+	return nil
+}
+
+func (s *fakeStream) Recv() (*protos.StreamingRateResponse, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+// TestGetProductsConcurrent requests prices in several currencies at once,
+// run it with -race to detect unguarded cache accesses
+func TestGetProductsConcurrent(t *testing.T) {
+	pdb := NewProductsDB(fakeCurrency{}, hclog.NewNullLogger())
+
+	// the subscription stream is opened in the background
+	deadline := time.Now().Add(time.Second)
+	for {
+		pdb.mu.RLock()
+		sub := pdb.client
+		pdb.mu.RUnlock()
+
+		if sub != nil {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("expected the rate subscription to be opened")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(cur string) {
+			defer wg.Done()
+
+			_, err := pdb.GetProducts(cur)
+			if err != nil {
+				t.Error(err)
+			}
+		}([]string{"USD", "GBP", "JPY"}[i%3])
+	}
+	wg.Wait()
+
+	_, err := pdb.GetProducts("XXX")
+	if !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Fatalf("expected ErrUnsupportedCurrency, got %v", err)
+	}
+}
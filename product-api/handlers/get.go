@@ -1,10 +1,12 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/ellofae/RESTful-API-Gorilla/data"
 	"github.com/gorilla/mux"
 )
 
@@ -24,8 +26,14 @@ func (p *Products) GetProducts(rw http.ResponseWriter, r *http.Request) {
 	cur := r.URL.Query().Get("currency")
 
 	lp, err := p.productDB.GetProducts(cur)
+	if errors.Is(err, data.ErrUnsupportedCurrency) {
+		http.Error(rw, fmt.Sprintf("Error: %v", err), http.StatusBadRequest)
+		return
+	}
+
 	if err != nil {
 		p.l.Error("Didn't manage to get the list of products", "error", err)
+		http.Error(rw, "Didn't manage to get the list of products", http.StatusInternalServerError)
 		return
 	}
 
@@ -39,10 +39,11 @@ func main() {
 
 	sm := mux.NewRouter()
 	getRouter := sm.Methods(http.MethodGet).Subrouter()
-	getRouter.HandleFunc("/products", ph.GetProducts).Queries("currency", "{[A-Z]{3}}")
+	// currencies are validated against the ones supported by the currency server
+	getRouter.HandleFunc("/products", ph.GetProducts).Queries("currency", "{currency}")
 	getRouter.HandleFunc("/products", ph.GetProducts)
 
-	getRouter.HandleFunc("/products/{id:[0-9]+}", ph.GetProductByID).Queries("currency", "{[A-Z]{3}}")
+	getRouter.HandleFunc("/products/{id:[0-9]+}", ph.GetProductByID).Queries("currency", "{currency}")
 	getRouter.HandleFunc("/products/{id:[0-9]+}", ph.GetProductByID)
 
 	postRouter := sm.Methods(http.MethodPost).Subrouter()
@@ -93,7 +94,7 @@ func main() {
 		}
 	}()
 
-	sigChan := make(chan os.Signal)
+	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt)
 	signal.Notify(sigChan, os.Kill)
 
@@ -101,6 +102,7 @@ func main() {
 	l.Info("Recived terminate, gracefil shutdown", "signal", sig)
 
 	// Graceful shutdown
-	tc, _ := context.WithTimeout(context.Background(), 30*time.Second)
+	tc, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 	srv.Shutdown(tc)
 }